@@ -189,6 +189,11 @@ func SetAttribute(o *js.Object, key string, value string) {
 	o.Call("setAttribute", key, value)
 }
 
+// RemoveAttribute calls removeAttribute on the js object with the given key
+func RemoveAttribute(o *js.Object, key string) {
+	o.Call("removeAttribute", key)
+}
+
 // SetInnerHTML calls the innerHTML setter with the given string
 func SetInnerHTML(o *js.Object, html string) {
 	o.Set("innerHTML", html)
@@ -248,6 +248,13 @@ patchloop:
 			SetAttribute(target, key, value)
 		}
 
+		// drop attributes the new node no longer carries, e.g RemoveAttr calls.
+		for key := range Attributes(target) {
+			if _, ok := attrs[key]; !ok {
+				RemoveAttribute(target, key)
+			}
+		}
+
 		children := ChildNodeList(target)
 
 		// log.Printf("checking targets children %+s %d", target, len(children))
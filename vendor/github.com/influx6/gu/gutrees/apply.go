@@ -0,0 +1,151 @@
+package gutrees
+
+import "fmt"
+
+// ApplyPatches mutates root according to patches, addressed the same way
+// Diff produces them: Path is a child-index array counted from root. It's
+// symmetric to MarshalPatches/Diff - useful for keeping a server-side
+// shadow tree in sync with a client's DOM, or for round-tripping a diff in
+// tests.
+//
+// Patches are applied in order; if one fails because its Path no longer
+// resolves (e.g. two patches both touch a since-removed subtree), that
+// patch is skipped and the remaining patches are still applied, so the
+// tree reflects every patch that could be applied. ApplyPatches then
+// returns an error describing the first such failure; patches applied
+// before or after it are not rolled back.
+func ApplyPatches(root *Element, patches []Patch) error {
+	var firstErr error
+
+	for _, p := range patches {
+		if err := applyPatch(root, p); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+func applyPatch(root *Element, p Patch) error {
+	switch p.Op {
+	case PatchAttrs:
+		node, err := nodeAt(root, p.Path)
+		if err != nil {
+			return err
+		}
+		for name, value := range p.Attrs {
+			(&Attribute{Name: name, Value: value}).Apply(node)
+		}
+		return nil
+
+	case PatchText:
+		node, err := nodeAt(root, p.Path)
+		if err != nil {
+			return err
+		}
+		node.textContent = p.HTML
+		return nil
+
+	case PatchReplace:
+		parent, idx, err := parentAt(root, p.Path)
+		if err != nil {
+			return err
+		}
+		if idx < 0 || idx >= len(parent.children) {
+			return fmt.Errorf("gutrees: patch path %v has no node at depth %d", p.Path, len(p.Path)-1)
+		}
+		replacement, err := parseSingle(p.HTML)
+		if err != nil {
+			return err
+		}
+		replacement.parent = parent
+		parent.children[idx] = replacement
+		return nil
+
+	case PatchInsert:
+		parent, idx, err := parentAt(root, p.Path)
+		if err != nil {
+			return err
+		}
+		inserted, err := parseSingle(p.HTML)
+		if err != nil {
+			return err
+		}
+		inserted.parent = parent
+		if idx > len(parent.children) {
+			idx = len(parent.children)
+		}
+		children := append(parent.children[:idx:idx], inserted)
+		parent.children = append(children, parent.children[idx:]...)
+		return nil
+
+	case PatchRemove:
+		parent, idx, err := parentAt(root, p.Path)
+		if err != nil {
+			return err
+		}
+		if idx < 0 || idx >= len(parent.children) {
+			return fmt.Errorf("gutrees: patch path %v has no node at depth %d", p.Path, len(p.Path)-1)
+		}
+		parent.children = append(parent.children[:idx], parent.children[idx+1:]...)
+		return nil
+
+	default:
+		return fmt.Errorf("gutrees: unknown patch op %q", p.Op)
+	}
+}
+
+// parseSingle parses html and returns its first top-level element, for
+// rebuilding a node from the fragment a PatchReplace/PatchInsert carries.
+// The hash/uid attributes ElementWriter bakes into rendered output are
+// bookkeeping, not content - every node already gets its own fresh pair
+// from NewElement, so the parsed-out copies are stripped to avoid ending
+// up with both.
+func parseSingle(html string) (*Element, error) {
+	frag, err := Parse(html)
+	if err != nil {
+		return nil, err
+	}
+
+	children := childElements(frag)
+	if len(children) == 0 {
+		return nil, fmt.Errorf("gutrees: patch HTML %q produced no element", html)
+	}
+
+	root := children[0]
+	Walk(root, func(e *Element) bool {
+		e.RemoveAttr("hash")
+		e.RemoveAttr("uid")
+		return true
+	})
+
+	return root, nil
+}
+
+// nodeAt resolves path to the element it addresses, counted from root.
+func nodeAt(root *Element, path []int) (*Element, error) {
+	node := root
+	for depth, idx := range path {
+		children := childElements(node)
+		if idx < 0 || idx >= len(children) {
+			return nil, fmt.Errorf("gutrees: patch path %v has no node at depth %d", path, depth)
+		}
+		node = children[idx]
+	}
+	return node, nil
+}
+
+// parentAt resolves the parent and final index path[len(path)-1] points
+// to, for ops that add or remove a child rather than address one directly.
+func parentAt(root *Element, path []int) (*Element, int, error) {
+	if len(path) == 0 {
+		return nil, 0, fmt.Errorf("gutrees: patch path must not be empty")
+	}
+
+	parent, err := nodeAt(root, path[:len(path)-1])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return parent, path[len(path)-1], nil
+}
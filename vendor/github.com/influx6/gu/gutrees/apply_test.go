@@ -0,0 +1,50 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func buildList(items ...string) *gutrees.Element {
+	ul := gutrees.NewElement("ul", false)
+	(&gutrees.Attribute{Name: "id", Value: "list"}).Apply(ul)
+
+	for _, text := range items {
+		li := gutrees.NewElement("li", false)
+		gutrees.NewText(text).Apply(li)
+		li.Apply(ul)
+	}
+
+	return ul
+}
+
+func TestApplyPatchesMatchesDiffedTarget(t *testing.T) {
+	oldTree := buildList("one", "two")
+	newTree := buildList("one", "two", "three")
+	(&gutrees.Attribute{Name: "class", Value: "active"}).Apply(newTree)
+
+	patches := gutrees.Diff(oldTree, newTree)
+
+	if err := gutrees.ApplyPatches(oldTree, patches); err != nil {
+		t.Fatalf("\t%s\t Should apply patches without error, got %s", failed, err)
+	}
+
+	if !gutrees.Equal(oldTree, newTree) {
+		t.Fatalf("\t%s\t Should reach a tree equal to newTree after applying the diff.\ngot:  %s\nwant: %s",
+			failed, string(gutrees.RenderBytes(oldTree)), string(gutrees.RenderBytes(newTree)))
+	}
+	t.Logf("\t%s\t Should turn oldTree into newTree by applying Diff's patches", success)
+}
+
+func TestApplyPatchesReportsUnresolvedPath(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+
+	err := gutrees.ApplyPatches(root, []gutrees.Patch{
+		{Op: gutrees.PatchAttrs, Path: []int{5}, Attrs: map[string]string{"id": "x"}},
+	})
+	if err == nil {
+		t.Fatalf("\t%s\t Should return an error for a patch whose path doesn't resolve", failed)
+	}
+	t.Logf("\t%s\t Should report an error rather than silently ignoring an unresolved patch path", success)
+}
@@ -0,0 +1,44 @@
+// Package aria provides constructors for common WAI-ARIA markup patterns
+// that need more than a single attribute, mirroring elems' style for
+// plain HTML elements.
+package aria
+
+import (
+	"fmt"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// Politeness levels LiveRegion accepts for aria-live.
+const (
+	PolitenessPolite    = "polite"
+	PolitenessAssertive = "assertive"
+)
+
+// roleForPoliteness maps each supported politeness level to the role
+// assistive technology conventionally expects alongside it - "status"
+// for routine updates, "alert" for ones urgent enough to interrupt.
+var roleForPoliteness = map[string]string{
+	PolitenessPolite:    "status",
+	PolitenessAssertive: "alert",
+}
+
+// LiveRegion wraps markup in a <div> that assistive technology announces
+// whenever its content changes, for dynamic status messages like toasts
+// or form validation errors. politeness must be PolitenessPolite or
+// PolitenessAssertive; anything else is rejected rather than silently
+// producing a region screen readers won't announce correctly.
+func LiveRegion(politeness string, markup ...gutrees.Appliable) (*gutrees.Element, error) {
+	role, ok := roleForPoliteness[politeness]
+	if !ok {
+		return nil, fmt.Errorf("aria: invalid politeness %q, must be %q or %q", politeness, PolitenessPolite, PolitenessAssertive)
+	}
+
+	e := elems.Div(markup...)
+	attrs.Aria("live", politeness).Apply(e)
+	attrs.Aria("atomic", "true").Apply(e)
+	(&gutrees.Attribute{Name: "role", Value: role}).Apply(e)
+	return e, nil
+}
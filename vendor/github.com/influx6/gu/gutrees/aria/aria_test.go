@@ -0,0 +1,45 @@
+package aria_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/aria"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestLiveRegionSetsPoliteAttributes(t *testing.T) {
+	region, err := aria.LiveRegion(aria.PolitenessPolite)
+	if err != nil {
+		t.Fatalf("\t%s\t Should build a polite live region, got err %s", failed, err)
+	}
+
+	out := string(gutrees.RenderBytes(region))
+	if !strings.Contains(out, `aria-live="polite"`) || !strings.Contains(out, `role="status"`) {
+		t.Fatalf("\t%s\t Should set aria-live=polite and role=status, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should mark a polite region with aria-live and role=status", success)
+}
+
+func TestLiveRegionSetsAssertiveAttributes(t *testing.T) {
+	region, err := aria.LiveRegion(aria.PolitenessAssertive)
+	if err != nil {
+		t.Fatalf("\t%s\t Should build an assertive live region, got err %s", failed, err)
+	}
+
+	out := string(gutrees.RenderBytes(region))
+	if !strings.Contains(out, `aria-live="assertive"`) || !strings.Contains(out, `role="alert"`) {
+		t.Fatalf("\t%s\t Should set aria-live=assertive and role=alert, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should mark an assertive region with aria-live and role=alert", success)
+}
+
+func TestLiveRegionRejectsInvalidPoliteness(t *testing.T) {
+	if _, err := aria.LiveRegion("loud"); err == nil {
+		t.Fatalf("\t%s\t Should reject an invalid politeness value", failed)
+	}
+	t.Logf("\t%s\t Should reject a politeness value that isn't polite or assertive", success)
+}
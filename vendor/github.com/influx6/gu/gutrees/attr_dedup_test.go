@@ -0,0 +1,36 @@
+package gutrees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestReapplyingAttributeOverwritesRatherThanDuplicates(t *testing.T) {
+	e := elems.Div(attrs.ID("a"))
+	attrs.ID("b").Apply(e)
+
+	found := 0
+	for _, a := range e.Attributes() {
+		if a.Name == "id" {
+			found++
+		}
+	}
+	if found != 1 {
+		t.Fatalf("\t%s\t Should have exactly one id attribute, found %d", failed, found)
+	}
+
+	id, err := gutrees.GetAttr(e, "id")
+	if err != nil || id.Value != "b" {
+		t.Fatalf("\t%s\t Should overwrite id's value, got %q (err=%v)", failed, id, err)
+	}
+
+	out := string(gutrees.RenderBytes(e))
+	if strings.Count(out, ` id="`) != 1 {
+		t.Fatalf("\t%s\t Should render a single id attribute, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should overwrite, not duplicate, a re-applied single-valued attribute", success)
+}
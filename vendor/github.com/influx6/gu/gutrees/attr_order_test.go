@@ -0,0 +1,51 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestAttributeStorePreservesInsertionOrder(t *testing.T) {
+	e := gutrees.NewElement("div", false)
+	(&gutrees.Attribute{Name: "id", Value: "a"}).Apply(e)
+	(&gutrees.Attribute{Name: "class", Value: "b"}).Apply(e)
+	(&gutrees.Attribute{Name: "title", Value: "c"}).Apply(e)
+
+	attrs := e.Attributes()
+	if len(attrs) != 3 || attrs[0].Name != "id" || attrs[1].Name != "class" || attrs[2].Name != "title" {
+		t.Fatalf("\t%s\t Should preserve insertion order, got %+v", failed, attrs)
+	}
+	t.Logf("\t%s\t Should keep attributes in insertion order", success)
+}
+
+func TestAttributeStoreOverwritesInPlaceWithoutDuplicates(t *testing.T) {
+	e := gutrees.NewElement("div", false)
+	(&gutrees.Attribute{Name: "id", Value: "a"}).Apply(e)
+	(&gutrees.Attribute{Name: "class", Value: "b"}).Apply(e)
+	(&gutrees.Attribute{Name: "id", Value: "z"}).Apply(e)
+
+	attrs := e.Attributes()
+	if len(attrs) != 2 {
+		t.Fatalf("\t%s\t Should overwrite rather than duplicate, got %+v", failed, attrs)
+	}
+	if attrs[0].Name != "id" || attrs[0].Value != "z" {
+		t.Fatalf("\t%s\t Should overwrite the value in place, keeping its original position, got %+v", failed, attrs[0])
+	}
+	t.Logf("\t%s\t Should overwrite a repeated attribute in place, preserving its original position", success)
+}
+
+func TestAttributeStoreReindexesAfterRemoval(t *testing.T) {
+	e := gutrees.NewElement("div", false)
+	(&gutrees.Attribute{Name: "id", Value: "a"}).Apply(e)
+	(&gutrees.Attribute{Name: "class", Value: "b"}).Apply(e)
+
+	e.RemoveAttr("id")
+	(&gutrees.Attribute{Name: "class", Value: "updated"}).Apply(e)
+
+	attrs := e.Attributes()
+	if len(attrs) != 1 || attrs[0].Name != "class" || attrs[0].Value != "updated" {
+		t.Fatalf("\t%s\t Should keep the index consistent after a removal, got %+v", failed, attrs)
+	}
+	t.Logf("\t%s\t Should still overwrite correctly for attributes that shifted position after a removal", success)
+}
@@ -0,0 +1,43 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestNewValidAttrAcceptsValidName(t *testing.T) {
+	a, err := gutrees.NewValidAttr("data-id", "42")
+	if err != nil {
+		t.Fatalf("\t%s\t Should accept a well-formed attribute name, got %v", failed, err)
+	}
+	if a.Name != "data-id" || a.Value != "42" {
+		t.Fatalf("\t%s\t Should build the attribute with the given name and value, got %+v", failed, a)
+	}
+	t.Logf("\t%s\t Should accept a valid attribute name", success)
+}
+
+func TestNewValidAttrRejectsNameWithSpaces(t *testing.T) {
+	if _, err := gutrees.NewValidAttr("data id", "42"); err != gutrees.ErrInvalidAttrName {
+		t.Fatalf("\t%s\t Should reject a name containing a space, got %v", failed, err)
+	}
+	t.Logf("\t%s\t Should reject an attribute name with spaces", success)
+}
+
+func TestNewValidAttrRejectsNameWithEquals(t *testing.T) {
+	if _, err := gutrees.NewValidAttr("data=id", "42"); err != gutrees.ErrInvalidAttrName {
+		t.Fatalf("\t%s\t Should reject a name containing '=', got %v", failed, err)
+	}
+	t.Logf("\t%s\t Should reject an attribute name with '='", success)
+}
+
+func TestAttributeApplyDropsInvalidName(t *testing.T) {
+	e := elems.Div()
+	(&gutrees.Attribute{Name: "bad name", Value: "x"}).Apply(e)
+
+	if _, err := gutrees.GetAttr(e, "bad name"); err == nil {
+		t.Fatalf("\t%s\t Should not apply an attribute with an invalid name", failed)
+	}
+	t.Logf("\t%s\t Should drop an invalid attribute name on Apply instead of rendering broken HTML", success)
+}
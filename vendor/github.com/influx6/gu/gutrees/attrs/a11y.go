@@ -0,0 +1,48 @@
+package attrs
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// TabIndex returns an Appliable that sets tabindex to n. A positive
+// value pulls the element out of the page's natural tab order into an
+// explicit one, which is usually an accessibility anti-pattern - screen
+// reader and keyboard users expect to tab through content in document
+// order - so n > 0 is still applied, but logged as a warning. 0 and
+// negative values are unremarkable (0 joins the natural tab order,
+// negative makes the element focusable only programmatically) and are
+// applied silently.
+func TabIndex(n int) gutrees.Appliable {
+	if n > 0 {
+		log.Printf("gutrees: tabindex=%d pulls its element out of the natural tab order", n)
+	}
+	return &gutrees.Attribute{Name: "tabindex", Value: strconv.Itoa(n)}
+}
+
+// TabIndexOrOmit is TabIndex for a *int: a nil n omits the tabindex
+// attribute entirely, while a non-nil n is applied even when it points
+// at 0 - 0 is a meaningful tabindex (it joins the natural tab order),
+// distinct from not setting tabindex at all, so only nilness omits it.
+func TabIndexOrOmit(n *int) gutrees.Appliable {
+	if n == nil {
+		return noopAttr{}
+	}
+	return TabIndex(*n)
+}
+
+// AccessKey returns an Appliable that sets accesskey to key, a
+// single-character keyboard shortcut the browser binds to the element.
+// A key that isn't exactly one character is invalid HTML, so it's
+// dropped with a logged warning instead of applied - the same
+// "can't be applied, no error to report it through" convention
+// Attribute.Apply uses for an invalid attribute name.
+func AccessKey(key string) gutrees.Appliable {
+	if len([]rune(key)) != 1 {
+		log.Printf("gutrees: dropping accesskey %q, must be exactly one character", key)
+		return noopAttr{}
+	}
+	return &gutrees.Attribute{Name: "accesskey", Value: key}
+}
@@ -0,0 +1,60 @@
+package attrs_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+func TestTabIndexAppliesNegativeValue(t *testing.T) {
+	got := attrs.Render(attrs.TabIndex(-1))
+	want := ` tabindex="-1"`
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should apply a negative tabindex, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should apply a negative tabindex unremarkably", success)
+}
+
+func TestTabIndexAppliesPositiveValueDespiteWarning(t *testing.T) {
+	got := attrs.Render(attrs.TabIndex(3))
+	want := ` tabindex="3"`
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should still apply a positive tabindex, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should apply a positive tabindex despite the anti-pattern warning", success)
+}
+
+func TestTabIndexOrOmitOmitsNil(t *testing.T) {
+	got := attrs.Render(attrs.TabIndexOrOmit(nil))
+	if string(got) != "" {
+		t.Fatalf("\t%s\t Should omit tabindex for a nil pointer, got %q", failed, got)
+	}
+	t.Logf("\t%s\t Should omit tabindex when no value is given", success)
+}
+
+func TestTabIndexOrOmitKeepsExplicitZero(t *testing.T) {
+	zero := 0
+	got := attrs.Render(attrs.TabIndexOrOmit(&zero))
+	want := ` tabindex="0"`
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should keep an explicit zero tabindex, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should keep tabindex=0 when explicitly pointed to", success)
+}
+
+func TestAccessKeyRejectsMultiCharacterValue(t *testing.T) {
+	got := attrs.Render(attrs.AccessKey("ab"))
+	if string(got) != "" {
+		t.Fatalf("\t%s\t Should reject a multi-character accesskey, got %q", failed, got)
+	}
+	t.Logf("\t%s\t Should reject a multi-character accesskey", success)
+}
+
+func TestAccessKeyAcceptsSingleCharacter(t *testing.T) {
+	got := attrs.Render(attrs.AccessKey("s"))
+	want := ` accesskey="s"`
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should accept a single-character accesskey, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should accept a single-character accesskey", success)
+}
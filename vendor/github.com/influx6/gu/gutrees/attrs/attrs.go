@@ -128,6 +128,12 @@ func Type(val string) *gutrees.Attribute {
 	return &gutrees.Attribute{Name: "type", Value: val}
 }
 
+// InputMode defines attributes of type "Inputmode" for html element types,
+// hinting to the browser which virtual keyboard layout to show.
+func InputMode(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "inputmode", Value: val}
+}
+
 // Placeholder defines attributes of type "Placeholder" for html element types
 func Placeholder(val string) *gutrees.Attribute {
 	return &gutrees.Attribute{Name: "placeholder", Value: val}
@@ -137,3 +143,152 @@ func Placeholder(val string) *gutrees.Attribute {
 func Value(val string) *gutrees.Attribute {
 	return &gutrees.Attribute{Name: "value", Value: val}
 }
+
+// Aria defines a "aria-*" attribute for the given aria property name, e.g.
+// Aria("current", "page") produces aria-current="page".
+func Aria(name, val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "aria-" + name, Value: val}
+}
+
+// Integrity defines the "integrity" attribute used for Subresource
+// Integrity checks on <link>/<script> elements.
+func Integrity(hash string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "integrity", Value: hash}
+}
+
+// Crossorigin defines the "crossorigin" attribute controlling CORS mode for
+// resource fetches.
+func Crossorigin(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "crossorigin", Value: val}
+}
+
+// Alt defines attributes of type "Alt" for html element types
+func Alt(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "alt", Value: val}
+}
+
+// Media defines attributes of type "Media" for html element types
+func Media(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "media", Value: val}
+}
+
+// Srcset defines attributes of type "Srcset" for html element types
+func Srcset(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "srcset", Value: val}
+}
+
+// Sizes defines attributes of type "Sizes" for html element types
+func Sizes(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "sizes", Value: val}
+}
+
+// Kind defines attributes of type "Kind" for html element types
+func Kind(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "kind", Value: val}
+}
+
+// Srclang defines attributes of type "Srclang" for html element types
+func Srclang(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "srclang", Value: val}
+}
+
+// Default defines the boolean "default" attribute for html element types
+func Default(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "default", Value: val}
+}
+
+// DateTime defines the "datetime" attribute used on <time> elements
+func DateTime(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "datetime", Value: val}
+}
+
+// Property defines attributes of type "Property" for html element types,
+// e.g. the RDFa/Open Graph property="og:*" used on <meta> tags.
+func Property(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "property", Value: val}
+}
+
+// Content defines attributes of type "Content" for html element types
+func Content(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "content", Value: val}
+}
+
+// Selected defines attributes of type "Selected" for html element types
+func Selected(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "selected", Value: val}
+}
+
+// Label defines attributes of type "Label" for html element types
+func Label(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "label", Value: val}
+}
+
+// Method defines attributes of type "Method" for html element types
+func Method(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "method", Value: val}
+}
+
+// rtlRanges holds the Unicode code point ranges of the strong
+// right-to-left scripts AutoDir checks for: Hebrew, Arabic, Syriac,
+// Thaana, Arabic Extended-A and the Hebrew/Arabic presentation forms.
+var rtlRanges = []struct{ lo, hi rune }{
+	{0x0591, 0x07FF}, // Hebrew, Arabic, Syriac, Thaana
+	{0x08A0, 0x08FF}, // Arabic Extended-A
+	{0xFB1D, 0xFDFF}, // Hebrew/Arabic presentation forms
+	{0xFE70, 0xFEFF}, // Arabic presentation forms-B
+}
+
+// ltrRanges holds the Unicode code point ranges AutoDir treats as strong
+// left-to-right: Latin, Greek and Cyrillic.
+var ltrRanges = []struct{ lo, hi rune }{
+	{0x0041, 0x005A},
+	{0x0061, 0x007A},
+	{0x00C0, 0x02B8},
+	{0x0370, 0x0523},
+}
+
+// AutoDir inspects text for its first strongly directional character and
+// returns an Appliable that sets "dir" to "rtl" or "ltr" accordingly, or
+// "auto" if text contains no strongly directional character at all. For
+// an empty string it applies nothing at all, so callers can use it
+// unconditionally: elems.Paragraph(attrs.AutoDir(text), elems.Text(text)).
+func AutoDir(text string) gutrees.Appliable {
+	if text == "" {
+		return autoDir{}
+	}
+
+	for _, r := range text {
+		if inRanges(r, rtlRanges) {
+			return autoDir{&gutrees.Attribute{Name: "dir", Value: "rtl"}}
+		}
+		if inRanges(r, ltrRanges) {
+			return autoDir{&gutrees.Attribute{Name: "dir", Value: "ltr"}}
+		}
+	}
+
+	return autoDir{&gutrees.Attribute{Name: "dir", Value: "auto"}}
+}
+
+// autoDir wraps an optional dir Attribute, applying it only when present,
+// so AutoDir can stay a no-op for an empty string instead of emitting
+// dir="auto" for blank text.
+type autoDir struct {
+	attr *gutrees.Attribute
+}
+
+// Apply applies the wrapped dir attribute to e, or does nothing if AutoDir
+// found no text to infer a direction from.
+func (a autoDir) Apply(e gutrees.Markup) {
+	if a.attr != nil {
+		a.attr.Apply(e)
+	}
+}
+
+func inRanges(r rune, ranges []struct{ lo, hi rune }) bool {
+	for _, rg := range ranges {
+		if r >= rg.lo && r <= rg.hi {
+			return true
+		}
+	}
+	return false
+}
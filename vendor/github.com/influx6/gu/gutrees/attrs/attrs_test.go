@@ -0,0 +1,57 @@
+package attrs_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func dirOf(t *testing.T, text string) (string, bool) {
+	t.Helper()
+	e := elems.Paragraph(attrs.AutoDir(text))
+	at, err := gutrees.GetAttr(e, "dir")
+	if err != nil {
+		return "", false
+	}
+	return at.Value, true
+}
+
+func TestAutoDirArabic(t *testing.T) {
+	if dir, ok := dirOf(t, "مرحبا"); !ok || dir != "rtl" {
+		t.Fatalf("\t%s\t Should infer dir=rtl for Arabic text, got %q (set=%v)", failed, dir, ok)
+	}
+	t.Logf("\t%s\t Should infer dir=rtl for Arabic text", success)
+}
+
+func TestAutoDirHebrew(t *testing.T) {
+	if dir, ok := dirOf(t, "שלום"); !ok || dir != "rtl" {
+		t.Fatalf("\t%s\t Should infer dir=rtl for Hebrew text, got %q (set=%v)", failed, dir, ok)
+	}
+	t.Logf("\t%s\t Should infer dir=rtl for Hebrew text", success)
+}
+
+func TestAutoDirLatin(t *testing.T) {
+	if dir, ok := dirOf(t, "Hello there"); !ok || dir != "ltr" {
+		t.Fatalf("\t%s\t Should infer dir=ltr for Latin text, got %q (set=%v)", failed, dir, ok)
+	}
+	t.Logf("\t%s\t Should infer dir=ltr for Latin text", success)
+}
+
+func TestAutoDirUndetermined(t *testing.T) {
+	if dir, ok := dirOf(t, "123 456"); !ok || dir != "auto" {
+		t.Fatalf("\t%s\t Should infer dir=auto when no strong character is found, got %q (set=%v)", failed, dir, ok)
+	}
+	t.Logf("\t%s\t Should infer dir=auto for text with no strong directional character", success)
+}
+
+func TestAutoDirEmpty(t *testing.T) {
+	if _, ok := dirOf(t, ""); ok {
+		t.Fatalf("\t%s\t Should not set dir at all for an empty string", failed)
+	}
+	t.Logf("\t%s\t Should leave dir unset for an empty string", success)
+}
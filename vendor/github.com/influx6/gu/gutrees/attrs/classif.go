@@ -0,0 +1,31 @@
+package attrs
+
+import "github.com/influx6/gu/gutrees"
+
+// ClassIf returns an Appliable that adds class if cond is true, and does
+// nothing otherwise. It merges additively with any existing class
+// attribute the same way Class does, so it composes with other class
+// appliers on the same element.
+func ClassIf(cond bool, class string) gutrees.Appliable {
+	list := &gutrees.ClassList{}
+	if cond {
+		list.Add(class)
+	}
+	return list
+}
+
+// Classes returns an Appliable that adds every key of m whose value is
+// true, in the classnames-package style (classnames({a: true, b: false})
+// => "a"). Since map iteration order isn't stable, the order classes end
+// up in the rendered attribute isn't guaranteed across calls - use Class
+// or SetClass instead if a specific order matters. It merges additively
+// with any existing class attribute.
+func Classes(m map[string]bool) gutrees.Appliable {
+	list := &gutrees.ClassList{}
+	for class, ok := range m {
+		if ok {
+			list.Add(class)
+		}
+	}
+	return list
+}
@@ -0,0 +1,40 @@
+package attrs_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestClassIfAddsClassOnlyWhenTrue(t *testing.T) {
+	e := elems.Div(attrs.ClassIf(true, "active"), attrs.ClassIf(false, "disabled"))
+
+	class, err := gutrees.GetAttr(e, "class")
+	if err != nil || class.Value != "active" {
+		t.Fatalf("\t%s\t Should only add the class whose condition is true, got %q (err=%v)", failed, class, err)
+	}
+	t.Logf("\t%s\t Should add a class conditionally", success)
+}
+
+func TestClassesAddsOnlyTrueKeys(t *testing.T) {
+	e := elems.Div(attrs.Classes(map[string]bool{
+		"active":   true,
+		"disabled": false,
+		"large":    true,
+	}))
+
+	class, err := gutrees.GetAttr(e, "class")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have a class attribute, got err %v", failed, err)
+	}
+	if strings.Contains(class.Value, "disabled") {
+		t.Fatalf("\t%s\t Should not include a key mapped to false, got %q", failed, class.Value)
+	}
+	if !strings.Contains(class.Value, "active") || !strings.Contains(class.Value, "large") {
+		t.Fatalf("\t%s\t Should include every key mapped to true, got %q", failed, class.Value)
+	}
+	t.Logf("\t%s\t Should build a class list from a classnames-style map", success)
+}
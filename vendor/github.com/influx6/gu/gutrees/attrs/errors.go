@@ -0,0 +1,11 @@
+package attrs
+
+import "errors"
+
+// ErrInvalidPattern is returned by Pattern when its regex argument fails
+// to compile.
+var ErrInvalidPattern = errors.New("invalid pattern regex")
+
+// ErrInvalidEventName is returned by On when its event argument isn't a
+// plausible DOM event name.
+var ErrInvalidEventName = errors.New("invalid event name")
@@ -0,0 +1,37 @@
+package attrs
+
+import (
+	"fmt"
+	"html"
+	"log"
+	"regexp"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// eventNameRe matches a DOM event name: lowercase letters only, the way
+// every native event (click, mouseover, dragstart, ...) is named.
+var eventNameRe = regexp.MustCompile(`^[a-z]+$`)
+
+// On builds an "on<event>" attribute (e.g. "onclick") whose value is js,
+// HTML-escaped for the attribute context the same way any other
+// attribute value would need to be - a browser decodes entities in an
+// attribute value before handing it to the JS engine, so escaping a
+// quote or angle bracket here doesn't change what runs, only how safely
+// it parses. event failing eventNameRe returns ErrInvalidEventName rather
+// than emitting a malformed attribute name.
+//
+// This bypasses gu's managed event system (guevents) entirely - there's
+// no handler registration, no cleanup, nothing to unwire if the element
+// is removed. It exists for pure-SSR pages with no client-side framework
+// that still want a handful of inline handlers; anywhere guevents is
+// already in use, prefer gutrees.NewEvent instead.
+func On(event, js string) (*gutrees.Attribute, error) {
+	if !eventNameRe.MatchString(event) {
+		return nil, fmt.Errorf("attrs: %w: %q", ErrInvalidEventName, event)
+	}
+
+	log.Printf("attrs: On(%q, ...) bypasses the managed event system; prefer gutrees.NewEvent where possible", event)
+
+	return &gutrees.Attribute{Name: "on" + event, Value: html.EscapeString(js)}, nil
+}
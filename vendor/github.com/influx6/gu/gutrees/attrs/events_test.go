@@ -0,0 +1,33 @@
+package attrs_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+func TestOnEscapesQuotesInHandlerBody(t *testing.T) {
+	attr, err := attrs.On("click", `alert("hi")`)
+	if err != nil {
+		t.Fatalf("\t%s\t Should accept a valid event name, got err %s", failed, err)
+	}
+
+	if attr.Name != "onclick" {
+		t.Fatalf("\t%s\t Should build an 'onclick' attribute, got %q", failed, attr.Name)
+	}
+	if strings.Contains(attr.Value, `"`) {
+		t.Fatalf("\t%s\t Should escape double quotes out of the handler body, got %q", failed, attr.Value)
+	}
+	if !strings.Contains(attr.Value, "alert(") {
+		t.Fatalf("\t%s\t Should preserve the rest of the handler body, got %q", failed, attr.Value)
+	}
+	t.Logf("\t%s\t Should escape quotes in an inline handler body", success)
+}
+
+func TestOnRejectsInvalidEventName(t *testing.T) {
+	if _, err := attrs.On("Click Me", "alert(1)"); err == nil {
+		t.Fatalf("\t%s\t Should reject an event name that isn't a plausible DOM event", failed)
+	}
+	t.Logf("\t%s\t Should reject a malformed event name", success)
+}
@@ -0,0 +1,38 @@
+package attrs
+
+import (
+	"sort"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// Map returns an Appliable that sets every key/value pair in m as an
+// attribute, in sorted-key order, so two calls with the same map always
+// produce the same attribute order regardless of Go's randomized map
+// iteration. Each key is validated the same way NewValidAttr does; a key
+// that fails gutrees.ValidAttrName is dropped with a logged warning
+// rather than applied, same as a plain *gutrees.Attribute would be.
+func Map(m map[string]string) gutrees.Appliable {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return mapAttrs{keys: keys, values: m}
+}
+
+// mapAttrs applies its keys, in order, as attributes with their
+// corresponding values from values.
+type mapAttrs struct {
+	keys   []string
+	values map[string]string
+}
+
+// Apply sets each key/value pair as an attribute on e, in sorted-key
+// order.
+func (m mapAttrs) Apply(e gutrees.Markup) {
+	for _, k := range m.keys {
+		(&gutrees.Attribute{Name: k, Value: m.values[k]}).Apply(e)
+	}
+}
@@ -0,0 +1,21 @@
+package attrs_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+func TestMapAppliesAttributesInSortedKeyOrder(t *testing.T) {
+	got := attrs.Render(attrs.Map(map[string]string{
+		"title": "hi",
+		"id":    "main",
+		"role":  "button",
+	}))
+	want := ` id="main" role="button" title="hi"`
+
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should apply map attributes in sorted key order, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should apply attrs.Map entries in deterministic, sorted order", success)
+}
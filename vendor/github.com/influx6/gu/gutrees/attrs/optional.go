@@ -0,0 +1,47 @@
+package attrs
+
+import (
+	"strconv"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// Optional returns an Appliable that sets name to value, or does
+// nothing if value is empty - useful for an attribute that's only
+// sometimes present, like attrs.Optional("placeholder", cfg.Placeholder),
+// without an if/else around the element's markup call.
+func Optional(name, value string) gutrees.Appliable {
+	if value == "" {
+		return noopAttr{}
+	}
+	return &gutrees.Attribute{Name: name, Value: value}
+}
+
+// OptionalPtr returns an Appliable that sets name to *value, or does
+// nothing if value is nil - the pointer equivalent of Optional, for
+// telling "absent" (nil) apart from "explicitly empty" (a non-nil
+// pointer to "") when that distinction matters to the caller.
+func OptionalPtr(name string, value *string) gutrees.Appliable {
+	if value == nil {
+		return noopAttr{}
+	}
+	return &gutrees.Attribute{Name: name, Value: *value}
+}
+
+// OptionalInt returns an Appliable that sets name to *value, or does
+// nothing if value is nil - the numeric equivalent of OptionalPtr, for a
+// helper like tabindex or minlength where 0 is a meaningful value in its
+// own right and only the absence of a value (nil) should omit the
+// attribute.
+func OptionalInt(name string, value *int) gutrees.Appliable {
+	if value == nil {
+		return noopAttr{}
+	}
+	return &gutrees.Attribute{Name: name, Value: strconv.Itoa(*value)}
+}
+
+// noopAttr applies nothing, for Optional/OptionalPtr's absent case.
+type noopAttr struct{}
+
+// Apply does nothing.
+func (noopAttr) Apply(gutrees.Markup) {}
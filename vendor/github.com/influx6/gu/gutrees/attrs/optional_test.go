@@ -0,0 +1,60 @@
+package attrs_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+func TestOptionalOmitsEmptyValue(t *testing.T) {
+	got := attrs.Render(attrs.Optional("placeholder", ""))
+	if string(got) != "" {
+		t.Fatalf("\t%s\t Should emit no attribute for an empty value, got %q", failed, got)
+	}
+	t.Logf("\t%s\t Should omit an attribute whose value is empty", success)
+}
+
+func TestOptionalIncludesNonEmptyValue(t *testing.T) {
+	got := attrs.Render(attrs.Optional("placeholder", "Search"))
+	want := ` placeholder="Search"`
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should emit the attribute for a non-empty value, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should include an attribute whose value is non-empty", success)
+}
+
+func TestOptionalPtrOmitsNilValue(t *testing.T) {
+	got := attrs.Render(attrs.OptionalPtr("title", nil))
+	if string(got) != "" {
+		t.Fatalf("\t%s\t Should emit no attribute for a nil pointer, got %q", failed, got)
+	}
+	t.Logf("\t%s\t Should omit an attribute whose pointer is nil", success)
+}
+
+func TestOptionalPtrIncludesExplicitEmptyValue(t *testing.T) {
+	empty := ""
+	got := attrs.Render(attrs.OptionalPtr("title", &empty))
+	want := ` title=""`
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should emit the attribute for an explicit empty string, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should distinguish a non-nil empty value from an absent one", success)
+}
+
+func TestOptionalIntOmitsNilValue(t *testing.T) {
+	got := attrs.Render(attrs.OptionalInt("width", nil))
+	if string(got) != "" {
+		t.Fatalf("\t%s\t Should emit no attribute for a nil pointer, got %q", failed, got)
+	}
+	t.Logf("\t%s\t Should omit an attribute whose int pointer is nil", success)
+}
+
+func TestOptionalIntIncludesExplicitZero(t *testing.T) {
+	zero := 0
+	got := attrs.Render(attrs.OptionalInt("width", &zero))
+	want := ` width="0"`
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should emit the attribute for an explicit zero, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should distinguish a non-nil zero value from an absent one", success)
+}
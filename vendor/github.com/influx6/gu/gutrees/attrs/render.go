@@ -0,0 +1,31 @@
+package attrs
+
+import (
+	"fmt"
+	"html"
+	htmltemplate "html/template"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// Render applies appliers to a throwaway element and serializes the
+// resulting attributes into a single fragment suitable for embedding
+// directly into an html/template template, e.g.
+// `<div {{.Attrs}}>` where Attrs is this function's return value.
+// Unlike AttrWriter.Print, which gutrees' own renderer uses and which
+// assumes its caller already produced safe values, Render HTML-escapes
+// each value for the attribute context, since callers outside this
+// package's control over content flow through it.
+func Render(appliers ...gutrees.Appliable) htmltemplate.HTMLAttr {
+	e := gutrees.NewElement("div", false)
+	for _, a := range appliers {
+		a.Apply(e)
+	}
+
+	var out string
+	for _, a := range e.Attributes() {
+		out += fmt.Sprintf(` %s="%s"`, a.Name, html.EscapeString(a.Value))
+	}
+
+	return htmltemplate.HTMLAttr(out)
+}
@@ -0,0 +1,27 @@
+package attrs_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+func TestRenderConcatenatesAttributes(t *testing.T) {
+	got := attrs.Render(attrs.ID("main"), attrs.Class("a", "b"))
+	want := ` id="main" class="a b"`
+
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should render appliers to an attribute fragment, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should render multiple appliers to a concatenated attribute fragment", success)
+}
+
+func TestRenderEscapesAttributeValue(t *testing.T) {
+	got := attrs.Render(attrs.Label(`"quoted" & <tagged>`))
+	want := ` label="&#34;quoted&#34; &amp; &lt;tagged&gt;"`
+
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should escape attribute values for the attribute context, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should HTML-escape a value containing quotes and angle brackets", success)
+}
@@ -0,0 +1,61 @@
+package attrs
+
+import (
+	"strings"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// Replace returns an Appliable that sets name to val, first removing any
+// attribute already on the element by that name, so the attribute ends up
+// after the element's other attributes. A plain *gutrees.Attribute,
+// attrs.Class included, already overwrites an existing value of the same
+// name in its original position - but a handful of multi-valued
+// attributes are commonly built up additively (ClassList, ClassIf and
+// Classes for "class", SecureExternalLinks for "rel"), so there needs to
+// be a way to say "this value, and only this value" instead. Replace is
+// that general escape hatch; SetClass and SetRel below are its
+// common-case convenience wrappers.
+func Replace(name, val string) gutrees.Appliable {
+	return replaceAttr{name: name, val: val}
+}
+
+// SetClass returns an Appliable that replaces any existing class
+// attribute with val, joined by spaces - unlike ClassList, ClassIf and
+// Classes, which add to an existing class attribute. attrs.Class does not
+// merge either: like any plain *gutrees.Attribute, it overwrites an
+// existing class value in place.
+func SetClass(val ...string) gutrees.Appliable {
+	return Replace("class", strings.Join(val, " "))
+}
+
+// SetRel returns an Appliable that replaces any existing rel attribute
+// with val, rather than merging with it the way SecureExternalLinks
+// does.
+func SetRel(val string) gutrees.Appliable {
+	return Replace("rel", val)
+}
+
+// replaceAttr removes any existing attribute of the same name before
+// applying its own, so the new attribute is appended after the element's
+// other attributes, where a plain *gutrees.Attribute would overwrite the
+// old value in its original position.
+type replaceAttr struct {
+	name string
+	val  string
+}
+
+// Apply removes any attribute named a.name already on e, then applies
+// a.name=a.val in its place.
+func (a replaceAttr) Apply(e gutrees.Markup) {
+	if em, ok := e.(*gutrees.Element); ok {
+		for {
+			if _, err := gutrees.GetAttr(em, a.name); err != nil {
+				break
+			}
+			em.RemoveAttr(a.name)
+		}
+	}
+
+	(&gutrees.Attribute{Name: a.name, Value: a.val}).Apply(e)
+}
@@ -0,0 +1,42 @@
+package attrs_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestClassIsAdditiveWhenAppliedTwice(t *testing.T) {
+	e := elems.Div(attrs.Class("a"))
+	(&gutrees.ClassList{"b"}).Apply(e)
+
+	class, err := gutrees.GetAttr(e, "class")
+	if err != nil || class.Value != "a b" {
+		t.Fatalf("\t%s\t Should merge classes additively, got %q (err=%v)", failed, class, err)
+	}
+	t.Logf("\t%s\t Should keep class additive across applications", success)
+}
+
+func TestSetClassReplacesExistingClass(t *testing.T) {
+	e := elems.Div(attrs.Class("a"))
+	attrs.SetClass("b").Apply(e)
+
+	class, err := gutrees.GetAttr(e, "class")
+	if err != nil || class.Value != "b" {
+		t.Fatalf("\t%s\t Should replace the existing class, got %q (err=%v)", failed, class, err)
+	}
+	t.Logf("\t%s\t Should replace rather than merge with SetClass", success)
+}
+
+func TestSetRelReplacesExistingRel(t *testing.T) {
+	e := elems.Anchor(attrs.Rel("nofollow"))
+	attrs.SetRel("noopener").Apply(e)
+
+	rel, err := gutrees.GetAttr(e, "rel")
+	if err != nil || rel.Value != "noopener" {
+		t.Fatalf("\t%s\t Should replace the existing rel, got %q (err=%v)", failed, rel, err)
+	}
+	t.Logf("\t%s\t Should replace rather than merge with SetRel", success)
+}
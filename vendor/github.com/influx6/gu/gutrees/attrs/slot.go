@@ -0,0 +1,10 @@
+package attrs
+
+import "github.com/influx6/gu/gutrees"
+
+// Slot returns an Appliable that sets the "slot" attribute to name,
+// assigning a light-DOM child to the named <slot> of whatever shadow
+// root it ends up under.
+func Slot(name string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "slot", Value: name}
+}
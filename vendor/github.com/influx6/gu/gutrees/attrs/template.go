@@ -0,0 +1,61 @@
+package attrs
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// placeholderRe matches a "{name}" placeholder in a URL template.
+var placeholderRe = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// HrefTemplate builds an "href" attribute from pattern by substituting
+// each "{name}" placeholder with params[name], URL-path-escaped so a
+// value can't inject extra path segments or otherwise break out of the
+// URL the way plain string concatenation can. A placeholder with no
+// matching entry in params is an error rather than being left in the
+// output literally, so a missing value is caught here instead of
+// surfacing as a broken link.
+func HrefTemplate(pattern string, params map[string]string) (*gutrees.Attribute, error) {
+	val, err := expandTemplate(pattern, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return Href(val), nil
+}
+
+// expandTemplate replaces every "{name}" placeholder in pattern with the
+// URL-path-escaped value of params[name].
+func expandTemplate(pattern string, params map[string]string) (string, error) {
+	var missing error
+
+	out := placeholderRe.ReplaceAllStringFunc(pattern, func(match string) string {
+		name := match[1 : len(match)-1]
+
+		val, ok := params[name]
+		if !ok {
+			if missing == nil {
+				missing = fmt.Errorf("attrs: no value for placeholder %q in template %q", name, pattern)
+			}
+			return match
+		}
+
+		return url.PathEscape(val)
+	})
+
+	if missing != nil {
+		return "", missing
+	}
+
+	return out, nil
+}
+
+// Query builds an "href" attribute consisting of just a query string,
+// e.g. Query(url.Values{"page": {"2"}}) produces href="?page=2", with
+// each value properly percent-encoded by url.Values.Encode.
+func Query(q url.Values) *gutrees.Attribute {
+	return Href("?" + q.Encode())
+}
@@ -0,0 +1,45 @@
+package attrs_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+func TestHrefTemplateSubstitutesPlaceholder(t *testing.T) {
+	a, err := attrs.HrefTemplate("/user/{id}/posts", map[string]string{"id": "42"})
+	if err != nil {
+		t.Fatalf("\t%s\t Should substitute a matched placeholder without error, got %v", failed, err)
+	}
+	if a.Value != "/user/42/posts" {
+		t.Fatalf("\t%s\t Should produce the substituted href, got %q", failed, a.Value)
+	}
+	t.Logf("\t%s\t Should substitute a matched placeholder", success)
+}
+
+func TestHrefTemplateEscapesValueNeedingEncoding(t *testing.T) {
+	a, err := attrs.HrefTemplate("/search/{term}", map[string]string{"term": "a/b c"})
+	if err != nil {
+		t.Fatalf("\t%s\t Should substitute without error, got %v", failed, err)
+	}
+	if a.Value != "/search/a%2Fb%20c" {
+		t.Fatalf("\t%s\t Should percent-encode a value containing '/' and a space, got %q", failed, a.Value)
+	}
+	t.Logf("\t%s\t Should percent-encode a placeholder value needing escaping", success)
+}
+
+func TestHrefTemplateErrorsOnUnmatchedPlaceholder(t *testing.T) {
+	if _, err := attrs.HrefTemplate("/user/{id}/posts", map[string]string{}); err == nil {
+		t.Fatalf("\t%s\t Should error when a placeholder has no matching param", failed)
+	}
+	t.Logf("\t%s\t Should error on an unmatched placeholder", success)
+}
+
+func TestQueryEncodesValues(t *testing.T) {
+	a := attrs.Query(url.Values{"q": {"a b"}})
+	if a.Value != "?q=a+b" {
+		t.Fatalf("\t%s\t Should render an encoded query string, got %q", failed, a.Value)
+	}
+	t.Logf("\t%s\t Should build an href from url.Values", success)
+}
@@ -0,0 +1,68 @@
+package attrs
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// Required builds a "required" attribute toggling native form validation
+// for the field. A false value is still rendered (e.g. required="false"),
+// since gutrees attributes don't have a way to withhold themselves from
+// an element once applied - use Required(true) only when you want the
+// attribute present at all.
+func Required(val bool) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "required", Value: strconv.FormatBool(val)}
+}
+
+// Pattern builds a "pattern" attribute from regex, for the browser to
+// validate the field's value against. regex is compiled first so a typo
+// in the pattern is caught here rather than silently failing to validate
+// anything client-side; ErrInvalidPattern is returned (wrapping the
+// underlying regexp error) if it doesn't compile.
+func Pattern(regex string) (*gutrees.Attribute, error) {
+	if _, err := regexp.Compile(regex); err != nil {
+		return nil, fmt.Errorf("attrs: %w: %v", ErrInvalidPattern, err)
+	}
+
+	return &gutrees.Attribute{Name: "pattern", Value: regex}, nil
+}
+
+// MinLength builds a "minlength" attribute for the field.
+func MinLength(n int) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "minlength", Value: strconv.Itoa(n)}
+}
+
+// MaxLength builds a "maxlength" attribute for the field.
+func MaxLength(n int) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "maxlength", Value: strconv.Itoa(n)}
+}
+
+// MinLengthOrOmit is MinLength for a *int: a nil n omits the minlength
+// attribute entirely, while a non-nil n is applied even when it points
+// at 0 - unlike TabIndexOrOmit's tabindex, a minlength of 0 has no
+// effect on validation, but it's still kept here for the caller who set
+// it explicitly; use nil, not a pointer to 0, to leave the field unset.
+func MinLengthOrOmit(n *int) gutrees.Appliable {
+	return OptionalInt("minlength", n)
+}
+
+// MaxLengthOrOmit is MaxLength for a *int, following the same nil-omits
+// policy as MinLengthOrOmit.
+func MaxLengthOrOmit(n *int) gutrees.Appliable {
+	return OptionalInt("maxlength", n)
+}
+
+// Min builds a "min" attribute for the field, as a string since it's
+// valid for numeric, date and time inputs alike (e.g. "0", "2024-01-01").
+func Min(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "min", Value: val}
+}
+
+// Max builds a "max" attribute for the field, as a string for the same
+// reason as Min.
+func Max(val string) *gutrees.Attribute {
+	return &gutrees.Attribute{Name: "max", Value: val}
+}
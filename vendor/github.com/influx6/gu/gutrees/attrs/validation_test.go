@@ -0,0 +1,43 @@
+package attrs_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+func TestPatternAcceptsValidRegex(t *testing.T) {
+	attr, err := attrs.Pattern(`[a-z]+`)
+	if err != nil {
+		t.Fatalf("\t%s\t Should accept a valid regex, got err %s", failed, err)
+	}
+	if attr.Name != "pattern" || attr.Value != `[a-z]+` {
+		t.Fatalf("\t%s\t Should build a 'pattern' attribute, got %+v", failed, attr)
+	}
+	t.Logf("\t%s\t Should build a pattern attribute from a valid regex", success)
+}
+
+func TestPatternRejectsInvalidRegex(t *testing.T) {
+	if _, err := attrs.Pattern(`[a-z`); err == nil {
+		t.Fatalf("\t%s\t Should reject an unparseable regex", failed)
+	}
+	t.Logf("\t%s\t Should reject an invalid regex instead of rendering it unchecked", success)
+}
+
+func TestMinLengthOrOmitOmitsNil(t *testing.T) {
+	got := attrs.Render(attrs.MinLengthOrOmit(nil))
+	if string(got) != "" {
+		t.Fatalf("\t%s\t Should omit minlength for a nil pointer, got %q", failed, got)
+	}
+	t.Logf("\t%s\t Should omit minlength when no value is given", success)
+}
+
+func TestMaxLengthOrOmitKeepsExplicitZero(t *testing.T) {
+	zero := 0
+	got := attrs.Render(attrs.MaxLengthOrOmit(&zero))
+	want := ` maxlength="0"`
+	if string(got) != want {
+		t.Fatalf("\t%s\t Should keep an explicit zero maxlength, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should keep maxlength=0 when explicitly pointed to", success)
+}
@@ -0,0 +1,93 @@
+package gutrees
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// binaryElement mirrors the parts of *Element that survive a round trip
+// through MarshalBinary/UnmarshalBinary: tag, text content, autoclose,
+// attributes and styles (both in their existing order) and children.
+// Event handlers, the eventManager, and the lazy/custom-render hooks are
+// all dropped - they're Go closures or runtime-only values with no
+// meaningful binary encoding, and the whole point of this format is
+// shipping a rendered tree's content between processes. Its fields are
+// exported only because gob requires that; it isn't part of this
+// package's public API.
+type binaryElement struct {
+	Tag         string
+	TextContent string
+	Autoclose   bool
+	Attrs       []Attribute
+	Styles      []Style
+	Children    []*binaryElement
+}
+
+// toBinaryElement copies e's content (not its identity: uid/hash are
+// regenerated on decode) into a binaryElement tree.
+func toBinaryElement(e *Element) *binaryElement {
+	be := &binaryElement{
+		Tag:         e.tagname,
+		TextContent: e.textContent,
+		Autoclose:   e.autoclose,
+	}
+
+	for _, a := range e.attrs {
+		be.Attrs = append(be.Attrs, *a)
+	}
+	for _, s := range e.styles {
+		be.Styles = append(be.Styles, *s)
+	}
+	for _, ch := range e.children {
+		if ech, ok := ch.(*Element); ok {
+			be.Children = append(be.Children, toBinaryElement(ech))
+		}
+	}
+
+	return be
+}
+
+// toElement rebuilds an *Element from be, via the same Apply calls
+// ordinary construction uses, so the result behaves like any other tree
+// (a fresh uid/hash, reindexed attributes, and so on).
+func (be *binaryElement) toElement() *Element {
+	e := NewElement(be.Tag, be.Autoclose)
+	e.textContent = be.TextContent
+
+	for _, a := range be.Attrs {
+		attr := a
+		(&attr).Apply(e)
+	}
+	for _, s := range be.Styles {
+		style := s
+		(&style).Apply(e)
+	}
+	for _, ch := range be.Children {
+		ch.toElement().Apply(e)
+	}
+
+	return e
+}
+
+// MarshalBinary encodes e's tag, text, attributes, styles and children
+// into a compact gob-based format - smaller and faster to decode than
+// JSON for caching a rendered tree between processes. Event handlers and
+// other closures carried on e are dropped; UnmarshalBinary reproduces
+// the same rendered output, not the same Go values.
+func MarshalBinary(e *Element) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(toBinaryElement(e)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary back into an
+// *Element tree.
+func UnmarshalBinary(data []byte) (*Element, error) {
+	var be binaryElement
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&be); err != nil {
+		return nil, err
+	}
+	return be.toElement(), nil
+}
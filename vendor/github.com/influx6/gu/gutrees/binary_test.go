@@ -0,0 +1,46 @@
+package gutrees_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestMarshalBinaryRoundTripPreservesRenderedOutput(t *testing.T) {
+	tree := buildCard()
+
+	data, err := gutrees.MarshalBinary(tree)
+	if err != nil {
+		t.Fatalf("\t%s\t Should marshal without error, got %s", failed, err)
+	}
+
+	decoded, err := gutrees.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("\t%s\t Should unmarshal without error, got %s", failed, err)
+	}
+
+	// RenderHash, not RenderBytes, since decoding regenerates uid/hash
+	// management attributes - the content they carry is random by
+	// design and isn't part of what a round trip should preserve.
+	if gutrees.RenderHash(decoded) != gutrees.RenderHash(tree) {
+		t.Fatalf("\t%s\t Should render identically after a round trip", failed)
+	}
+	t.Logf("\t%s\t Should preserve rendered content across a binary round trip", success)
+}
+
+func BenchmarkMarshalBinary(b *testing.B) {
+	tree := buildCard()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = gutrees.MarshalBinary(tree)
+	}
+}
+
+func BenchmarkMarshalJSONEquivalent(b *testing.B) {
+	tree := buildCard()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = json.Marshal(string(gutrees.RenderBytes(tree)))
+	}
+}
@@ -0,0 +1,45 @@
+package gutrees
+
+import "bytes"
+
+// RenderCapped renders e like RenderStreaming, but fails fast with
+// ErrOutputTooLarge as soon as the output would exceed maxBytes, so a
+// large or runaway tree (e.g. a user-authored component) can't exhaust
+// memory before being rejected. The cap is checked incrementally by the
+// underlying streaming writer as each piece is produced, not after
+// buffering the whole thing. It returns whatever was written before the
+// cap was hit alongside the error.
+func RenderCapped(e *Element, maxBytes int) (string, error) {
+	return RenderCappedWith(SimpleElementWriter, e, maxBytes)
+}
+
+// RenderCappedWith behaves like RenderCapped, but renders with ew
+// instead of SimpleElementWriter.
+func RenderCappedWith(ew *ElementWriter, e *Element, maxBytes int) (string, error) {
+	cw := &cappedWriter{max: maxBytes}
+	err := RenderStreamingWith(cw, ew, e, nil)
+	return cw.buf.String(), err
+}
+
+// cappedWriter buffers everything written to it, failing with
+// ErrOutputTooLarge as soon as the total would exceed max.
+type cappedWriter struct {
+	buf bytes.Buffer
+	max int
+}
+
+// Write appends p to the buffer, or as much of it as fits under max,
+// returning ErrOutputTooLarge once the cap is reached.
+func (c *cappedWriter) Write(p []byte) (int, error) {
+	remaining := c.max - c.buf.Len()
+	if remaining <= 0 {
+		return 0, ErrOutputTooLarge
+	}
+
+	if len(p) > remaining {
+		n, _ := c.buf.Write(p[:remaining])
+		return n, ErrOutputTooLarge
+	}
+
+	return c.buf.Write(p)
+}
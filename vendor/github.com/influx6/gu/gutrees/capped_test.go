@@ -0,0 +1,39 @@
+package gutrees_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestRenderCappedStopsAtSizeLimit(t *testing.T) {
+	var paras []gutrees.Appliable
+	for i := 0; i < 200; i++ {
+		paras = append(paras, elems.Paragraph(elems.Text("hello world")))
+	}
+	tree := elems.Div(paras...)
+
+	out, err := gutrees.RenderCapped(tree, 100)
+	if !errors.Is(err, gutrees.ErrOutputTooLarge) {
+		t.Fatalf("\t%s\t Should fail with ErrOutputTooLarge for a small cap, got %v", failed, err)
+	}
+	if len(out) > 100 {
+		t.Fatalf("\t%s\t Should not return more than the cap's worth of output, got %d bytes", failed, len(out))
+	}
+	t.Logf("\t%s\t Should stop rendering once the size cap is exceeded", success)
+}
+
+func TestRenderCappedSucceedsUnderLimit(t *testing.T) {
+	tree := elems.Paragraph(elems.Text("hi"))
+
+	out, err := gutrees.RenderCapped(tree, 10000)
+	if err != nil {
+		t.Fatalf("\t%s\t Should render without error under a generous cap, got %s", failed, err)
+	}
+	if out == "" {
+		t.Fatalf("\t%s\t Should produce output", failed)
+	}
+	t.Logf("\t%s\t Should render normally when under the cap", success)
+}
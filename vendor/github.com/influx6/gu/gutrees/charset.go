@@ -0,0 +1,34 @@
+package gutrees
+
+// EnsureCharsetMeta walks root looking for a <head> element and, unless it
+// already contains a <meta charset="..."> child, prepends
+// <meta charset="utf-8"> as its first child. Without an explicit charset
+// meta a browser falls back to guessing a document's encoding, so this is
+// a cheap correctness nicety to run over a tree before a full-document
+// render.
+func EnsureCharsetMeta(root *Element) {
+	Walk(root, func(e *Element) bool {
+		if e.Name() != "head" {
+			return true
+		}
+
+		if e.rejectIfFrozen("EnsureCharsetMeta") {
+			return false
+		}
+
+		for _, ch := range e.children {
+			if ech, ok := ch.(*Element); ok && ech.Name() == "meta" {
+				if _, err := GetAttr(ech, "charset"); err == nil {
+					return false
+				}
+			}
+		}
+
+		meta := NewElement("meta", true)
+		(&Attribute{Name: "charset", Value: "utf-8"}).Apply(meta)
+		meta.parent = e
+		e.children = append([]Markup{meta}, e.children...)
+
+		return false
+	})
+}
@@ -0,0 +1,41 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestEnsureCharsetMetaInjectsWhenAbsent(t *testing.T) {
+	head := gutrees.NewElement("head", false)
+	gutrees.NewElement("title", false).Apply(head)
+
+	gutrees.EnsureCharsetMeta(head)
+
+	children := head.Children()
+	first, ok := children[0].(*gutrees.Element)
+	if !ok || first.Name() != "meta" {
+		t.Fatalf("\t%s\t Should inject a <meta charset> as the first head child, got %+v", failed, children[0])
+	}
+
+	charset, err := gutrees.GetAttr(first, "charset")
+	if err != nil || charset.Value != "utf-8" {
+		t.Fatalf("\t%s\t Should set charset=utf-8 on the injected meta", failed)
+	}
+	t.Logf("\t%s\t Should inject <meta charset=\"utf-8\"> as the first head child when absent", success)
+}
+
+func TestEnsureCharsetMetaLeavesExistingMetaAlone(t *testing.T) {
+	head := gutrees.NewElement("head", false)
+	existing := gutrees.NewElement("meta", true)
+	(&gutrees.Attribute{Name: "charset", Value: "iso-8859-1"}).Apply(existing)
+	existing.Apply(head)
+
+	gutrees.EnsureCharsetMeta(head)
+
+	children := head.Children()
+	if len(children) != 1 {
+		t.Fatalf("\t%s\t Should not duplicate an existing charset meta, got %d children", failed, len(children))
+	}
+	t.Logf("\t%s\t Should not inject a charset meta when one already exists", success)
+}
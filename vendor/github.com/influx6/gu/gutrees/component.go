@@ -0,0 +1,27 @@
+package gutrees
+
+// Renderable defines a reusable component that encapsulates its own state and
+// knows how to render its current subtree.
+type Renderable interface {
+	Render() *Element
+}
+
+// componentApplier adapts a Renderable into an Appliable so components can be
+// used directly within constructor calls.
+type componentApplier struct {
+	Renderable
+}
+
+// Apply renders the wrapped component and applies the resulting subtree onto
+// the parent markup. Calling Component again on the same Renderable and
+// applying it re-renders the component, producing a new subtree that the
+// normal Reconcile path will diff against the one already in the tree.
+func (c componentApplier) Apply(m Markup) {
+	c.Render().Apply(m)
+}
+
+// Component wraps a Renderable as an Appliable, ready to be used directly
+// within constructor calls, e.g. elems.Div(gutrees.Component(myComponent)).
+func Component(r Renderable) Appliable {
+	return componentApplier{Renderable: r}
+}
@@ -0,0 +1,37 @@
+package gutrees_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// counter is an example component encapsulating a bit of state and
+// rendering it into a subtree.
+type counter struct {
+	count int
+}
+
+func (c *counter) Render() *gutrees.Element {
+	return elems.Div(elems.Text(strconv.Itoa(c.count)))
+}
+
+func TestComponentApply(t *testing.T) {
+	c := &counter{count: 4}
+
+	wrapper := elems.Div(gutrees.Component(c))
+
+	children := wrapper.Children()
+	if len(children) != 1 {
+		t.Fatalf("\t%s\t Should have applied the rendered subtree as a single child, got %d", failed, len(children))
+	}
+	t.Logf("\t%s\t Should have applied the rendered subtree as a single child", success)
+
+	rendered, ok := children[0].(*gutrees.Element)
+	if !ok || rendered.Name() != "div" {
+		t.Fatalf("\t%s\t Should have applied the component's rendered div", failed)
+	}
+	t.Logf("\t%s\t Should have applied the component's rendered div", success)
+}
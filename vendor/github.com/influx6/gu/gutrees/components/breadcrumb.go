@@ -0,0 +1,43 @@
+// Package components provides higher-level, ready-to-use UI fragments built
+// on top of elems/attrs, for pieces that show up the same way across pages.
+package components
+
+import (
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// BreadcrumbItem describes a single crumb in a Breadcrumb trail.
+type BreadcrumbItem struct {
+	Label string
+	Href  string
+}
+
+// Breadcrumb renders an accessible breadcrumb trail as an ordered list inside
+// a <nav>. Every item but the last is a link; the last is rendered as plain
+// text carrying aria-current="page" since it represents the current page.
+// Separators are left to CSS (e.g. list-style or ::before) rather than
+// literal text so they don't pollute the accessibility tree.
+func Breadcrumb(items []BreadcrumbItem) *gutrees.Element {
+	var crumbs []gutrees.Appliable
+
+	for i, item := range items {
+		if i == len(items)-1 {
+			crumbs = append(crumbs, elems.ListItem(
+				attrs.Aria("current", "page"),
+				elems.Text(item.Label),
+			))
+			continue
+		}
+
+		crumbs = append(crumbs, elems.ListItem(
+			elems.Anchor(attrs.Href(item.Href), elems.Text(item.Label)),
+		))
+	}
+
+	return elems.Navigation(
+		attrs.Aria("label", "breadcrumb"),
+		elems.OrderedList(crumbs...),
+	)
+}
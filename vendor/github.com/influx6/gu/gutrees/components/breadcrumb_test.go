@@ -0,0 +1,34 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/components"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestBreadcrumbLastItemIsCurrentPage(t *testing.T) {
+	nav := components.Breadcrumb([]components.BreadcrumbItem{
+		{Label: "Home", Href: "/"},
+		{Label: "Budgets", Href: "/budgets"},
+		{Label: "Rent"},
+	})
+
+	list := nav.Children()[0].(*gutrees.Element)
+	lastItem := list.Children()[len(list.Children())-1].(*gutrees.Element)
+
+	if _, err := gutrees.GetAttr(lastItem, "aria-current"); err != nil {
+		t.Fatalf("\t%s\t Should set aria-current on the last item", failed)
+	}
+	t.Logf("\t%s\t Should set aria-current on the last item", success)
+
+	for _, child := range lastItem.Children() {
+		if ch, ok := child.(*gutrees.Element); ok && ch.Name() == "a" {
+			t.Fatalf("\t%s\t Should not render the last item as a link", failed)
+		}
+	}
+	t.Logf("\t%s\t Should not render the last item as a link", success)
+}
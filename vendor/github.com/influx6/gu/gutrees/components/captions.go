@@ -0,0 +1,37 @@
+package components
+
+import (
+	"regexp"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// bcp47Re is a loose match for a BCP-47 language tag (e.g. "en", "en-US",
+// "zh-Hans-CN") - enough to catch an obviously wrong value without
+// implementing the full subtag registry.
+var bcp47Re = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// Captions returns a <track kind="subtitles"> for use inside elems.Video or
+// elems.Audio, setting src, srclang and label together. isDefault renders
+// the boolean "default" attribute when true and omits it otherwise. A lang
+// that doesn't look like a BCP-47 tag is dropped rather than emitted
+// malformed.
+func Captions(src, lang, label string, isDefault bool) *gutrees.Element {
+	markup := []gutrees.Appliable{
+		attrs.Kind("subtitles"),
+		attrs.Src(src),
+		attrs.Label(label),
+	}
+
+	if bcp47Re.MatchString(lang) {
+		markup = append(markup, attrs.Srclang(lang))
+	}
+
+	if isDefault {
+		markup = append(markup, attrs.Default("default"))
+	}
+
+	return elems.Track(markup...)
+}
@@ -0,0 +1,45 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/components"
+)
+
+func TestCaptionsDefaultTrack(t *testing.T) {
+	track := components.Captions("captions-en.vtt", "en-US", "English", true)
+
+	if track.Name() != "track" {
+		t.Fatalf("\t%s\t Should render a <track>, got %q", failed, track.Name())
+	}
+
+	if k, err := gutrees.GetAttr(track, "kind"); err != nil || k.Value != "subtitles" {
+		t.Fatalf("\t%s\t Should set kind=\"subtitles\"", failed)
+	}
+	if s, err := gutrees.GetAttr(track, "srclang"); err != nil || s.Value != "en-US" {
+		t.Fatalf("\t%s\t Should set srclang for a valid BCP-47 tag", failed)
+	}
+	if d, err := gutrees.GetAttr(track, "default"); err != nil || d.Value != "default" {
+		t.Fatalf("\t%s\t Should render the boolean default attribute when isDefault is true", failed)
+	}
+	t.Logf("\t%s\t Should render kind/src/srclang/label and the boolean default attribute", success)
+}
+
+func TestCaptionsNonDefaultOmitsDefaultAttr(t *testing.T) {
+	track := components.Captions("captions-fr.vtt", "fr", "Francais", false)
+
+	if _, err := gutrees.GetAttr(track, "default"); err == nil {
+		t.Fatalf("\t%s\t Should omit the default attribute when isDefault is false", failed)
+	}
+	t.Logf("\t%s\t Should omit the default attribute when isDefault is false", success)
+}
+
+func TestCaptionsDropsInvalidLang(t *testing.T) {
+	track := components.Captions("captions.vtt", "not_a_lang_tag!", "Label", false)
+
+	if _, err := gutrees.GetAttr(track, "srclang"); err == nil {
+		t.Fatalf("\t%s\t Should drop srclang when it doesn't look like BCP-47", failed)
+	}
+	t.Logf("\t%s\t Should drop a malformed lang tag instead of emitting it", success)
+}
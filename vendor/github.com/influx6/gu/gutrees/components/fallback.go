@@ -0,0 +1,14 @@
+package components
+
+import (
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// WithFallback pairs an interactive element with a <noscript> fallback,
+// returning both as a fragment so neither contributes an extra wrapping
+// tag. Both branches render server-side; on the client, once scripting
+// takes over, the noscript content is simply never shown by the browser.
+func WithFallback(interactive, fallback *gutrees.Element) *gutrees.Element {
+	return gutrees.NewFragment(interactive, elems.NoScript(fallback))
+}
@@ -0,0 +1,38 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/components"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestWithFallbackRendersBothBranches(t *testing.T) {
+	interactive := elems.Button()
+	fallback := elems.Paragraph()
+
+	frag := components.WithFallback(interactive, fallback)
+
+	children := frag.Children()
+	if len(children) != 2 {
+		t.Fatalf("\t%s\t Should render the interactive element and a noscript wrapper, got %d children", failed, len(children))
+	}
+
+	got := children[0].(*gutrees.Element)
+	if got.Name() != "button" {
+		t.Fatalf("\t%s\t Should render the interactive element first, got %q", failed, got.Name())
+	}
+	t.Logf("\t%s\t Should render the interactive element server-side", success)
+
+	noscript := children[1].(*gutrees.Element)
+	if noscript.Name() != "noscript" {
+		t.Fatalf("\t%s\t Should wrap the fallback in a <noscript>, got %q", failed, noscript.Name())
+	}
+
+	fallbackChildren := noscript.Children()
+	if len(fallbackChildren) != 1 || fallbackChildren[0].(*gutrees.Element).Name() != "p" {
+		t.Fatalf("\t%s\t Should render the fallback element inside the noscript", failed)
+	}
+	t.Logf("\t%s\t Should render the fallback element server-side inside noscript", success)
+}
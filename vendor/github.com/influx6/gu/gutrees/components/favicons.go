@@ -0,0 +1,50 @@
+package components
+
+import (
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// FaviconIcon describes one sized icon link, e.g. a 32x32 PNG favicon or
+// a 180x180 apple-touch-icon.
+type FaviconIcon struct {
+	Rel   string
+	Href  string
+	Sizes string
+	Type  string
+}
+
+// FaviconSet describes the icon links and web manifest a page head needs
+// for favicons and PWA installability. Manifest is omitted if empty.
+type FaviconSet struct {
+	Icons    []FaviconIcon
+	Manifest string
+}
+
+// Favicons builds the <link> elements for set: one per icon, plus a
+// <link rel="manifest"> if set.Manifest is non-empty. Order matches the
+// order icons were given in, with the manifest link last, so callers can
+// drop the result straight into a page's <head>.
+func Favicons(set FaviconSet) []*gutrees.Element {
+	links := make([]*gutrees.Element, 0, len(set.Icons)+1)
+
+	for _, icon := range set.Icons {
+		markup := []gutrees.Appliable{attrs.Rel(icon.Rel), attrs.Href(icon.Href)}
+
+		if icon.Sizes != "" {
+			markup = append(markup, attrs.Sizes(icon.Sizes))
+		}
+		if icon.Type != "" {
+			markup = append(markup, attrs.Type(icon.Type))
+		}
+
+		links = append(links, elems.Link(markup...))
+	}
+
+	if set.Manifest != "" {
+		links = append(links, elems.Link(attrs.Rel("manifest"), attrs.Href(set.Manifest)))
+	}
+
+	return links
+}
@@ -0,0 +1,42 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/components"
+)
+
+func TestFaviconsRendersIconsAndManifest(t *testing.T) {
+	links := components.Favicons(components.FaviconSet{
+		Icons: []components.FaviconIcon{
+			{Rel: "icon", Href: "/favicon-32.png", Sizes: "32x32", Type: "image/png"},
+			{Rel: "apple-touch-icon", Href: "/apple-touch-icon.png", Sizes: "180x180"},
+		},
+		Manifest: "/site.webmanifest",
+	})
+
+	if len(links) != 3 {
+		t.Fatalf("\t%s\t Should render one link per icon plus the manifest, got %d", failed, len(links))
+	}
+
+	rel, _ := gutrees.GetAttr(links[0], "rel")
+	sizes, _ := gutrees.GetAttr(links[0], "sizes")
+	typ, _ := gutrees.GetAttr(links[0], "type")
+	if rel.Value != "icon" || sizes.Value != "32x32" || typ.Value != "image/png" {
+		t.Fatalf("\t%s\t Should set rel/sizes/type on the first icon, got rel=%+v sizes=%+v type=%+v", failed, rel, sizes, typ)
+	}
+	t.Logf("\t%s\t Should render a sized icon link with its type", success)
+
+	if _, err := gutrees.GetAttr(links[1], "type"); err == nil {
+		t.Fatalf("\t%s\t Should omit 'type' when none was given", failed)
+	}
+	t.Logf("\t%s\t Should omit optional attributes left unset", success)
+
+	manifestRel, _ := gutrees.GetAttr(links[2], "rel")
+	manifestHref, _ := gutrees.GetAttr(links[2], "href")
+	if manifestRel.Value != "manifest" || manifestHref.Value != "/site.webmanifest" {
+		t.Fatalf("\t%s\t Should render the manifest link last, got rel=%+v href=%+v", failed, manifestRel, manifestHref)
+	}
+	t.Logf("\t%s\t Should render the manifest link", success)
+}
@@ -0,0 +1,30 @@
+package components
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// JSONLD marshals v to JSON and embeds it in a
+// <script type="application/ld+json"> block, for structured data search
+// engines read for rich results. Content directly under <script> is
+// written out unescaped (it isn't HTML text), so a literal "</script>"
+// inside a marshaled value would otherwise terminate the tag early -
+// json.Encoder HTML-escapes '<', '>' and '&' to their \u00XX forms by
+// default for exactly this reason, so that sequence never reaches the
+// output literally.
+func JSONLD(v interface{}) (*gutrees.Element, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+
+	return elems.Script(
+		attrs.Type("application/ld+json"),
+		elems.Text(buf.String()),
+	), nil
+}
@@ -0,0 +1,39 @@
+package components_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/components"
+)
+
+func TestJSONLDEscapesScriptClosingTag(t *testing.T) {
+	data := struct {
+		Name string `json:"name"`
+	}{Name: `</script><script>alert(1)</script>`}
+
+	script, err := components.JSONLD(data)
+	if err != nil {
+		t.Fatalf("\t%s\t Should marshal without error, got %s", failed, err)
+	}
+
+	if script.Name() != "script" {
+		t.Fatalf("\t%s\t Should render a <script> element, got %q", failed, script.Name())
+	}
+
+	typ, err := gutrees.GetAttr(script, "type")
+	if err != nil || typ.Value != "application/ld+json" {
+		t.Fatalf("\t%s\t Should set type=\"application/ld+json\", got %+v, err %v", failed, typ, err)
+	}
+	t.Logf("\t%s\t Should render a JSON-LD script tag", success)
+
+	out := string(gutrees.RenderBytes(script))
+	if got := strings.Count(out, "</script>"); got != 1 {
+		t.Fatalf("\t%s\t Should only have the element's own closing tag, the embedded one should be escaped, got %d occurrences in %s", failed, got, out)
+	}
+	if !strings.Contains(out, "alert(1)") {
+		t.Fatalf("\t%s\t Should preserve the rest of the JSON content, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should escape a '</script>' sequence embedded in the marshaled JSON", success)
+}
@@ -0,0 +1,79 @@
+package components
+
+import (
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// Resource describes one asset to generate a preload hint for. As is the
+// destination type the browser needs to request and prioritize it
+// correctly (e.g. "font", "script", "image"); Type is its MIME type, and
+// Crossorigin is set when the request needs CORS (fonts always do, even
+// when served same-origin, per the fetch spec).
+type Resource struct {
+	Href        string
+	As          string
+	Type        string
+	Crossorigin string
+}
+
+// PreloadHints builds a <link rel="preload"> for each of resources, for
+// dropping into a page's <head> so the browser fetches critical assets
+// (fonts, critical CSS) before it would otherwise discover them.
+func PreloadHints(resources []Resource) []*gutrees.Element {
+	hints := make([]*gutrees.Element, 0, len(resources))
+
+	for _, r := range resources {
+		markup := []gutrees.Appliable{
+			attrs.Rel("preload"),
+			attrs.Href(r.Href),
+			&gutrees.Attribute{Name: "as", Value: r.As},
+		}
+
+		if r.Type != "" {
+			markup = append(markup, attrs.Type(r.Type))
+		}
+		if r.Crossorigin != "" {
+			markup = append(markup, attrs.Crossorigin(r.Crossorigin))
+		}
+
+		hints = append(hints, elems.Link(markup...))
+	}
+
+	return hints
+}
+
+// criticalAttr marks an element whose asset should be preloaded, e.g.
+// <img data-critical src="hero.jpg">.
+const criticalAttr = "data-critical"
+
+// AutoPreloadHints walks root for <img src> and <script src> elements
+// marked critical via the "data-critical" attribute, and returns a
+// preload hint for each - so marking an element critical is enough to
+// get it prioritized, without hand-building the matching Resource.
+func AutoPreloadHints(root *gutrees.Element) []*gutrees.Element {
+	var resources []Resource
+
+	gutrees.Walk(root, func(e *gutrees.Element) bool {
+		if _, err := gutrees.GetAttr(e, criticalAttr); err != nil {
+			return true
+		}
+
+		switch e.Name() {
+		case "img":
+			if src, err := gutrees.GetAttr(e, "src"); err == nil {
+				resources = append(resources, Resource{Href: src.Value, As: "image"})
+			}
+
+		case "script":
+			if src, err := gutrees.GetAttr(e, "src"); err == nil {
+				resources = append(resources, Resource{Href: src.Value, As: "script"})
+			}
+		}
+
+		return true
+	})
+
+	return PreloadHints(resources)
+}
@@ -0,0 +1,55 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/components"
+)
+
+func TestPreloadHintsSetsAsAndCrossoriginForFont(t *testing.T) {
+	hints := components.PreloadHints([]components.Resource{
+		{Href: "/fonts/brand.woff2", As: "font", Type: "font/woff2", Crossorigin: "anonymous"},
+	})
+
+	if len(hints) != 1 {
+		t.Fatalf("\t%s\t Should render one hint per resource, got %d", failed, len(hints))
+	}
+
+	link := hints[0]
+	rel, _ := gutrees.GetAttr(link, "rel")
+	as, _ := gutrees.GetAttr(link, "as")
+	typ, _ := gutrees.GetAttr(link, "type")
+	cross, _ := gutrees.GetAttr(link, "crossorigin")
+
+	if rel.Value != "preload" || as.Value != "font" || typ.Value != "font/woff2" || cross.Value != "anonymous" {
+		t.Fatalf("\t%s\t Should set rel/as/type/crossorigin for a font preload, got rel=%+v as=%+v type=%+v crossorigin=%+v",
+			failed, rel, as, typ, cross)
+	}
+	t.Logf("\t%s\t Should preload a font with as=font and crossorigin set", success)
+}
+
+func TestAutoPreloadHintsFindsCriticalAssets(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+
+	img := gutrees.NewElement("img", true)
+	(&gutrees.Attribute{Name: "src", Value: "/hero.jpg"}).Apply(img)
+	(&gutrees.Attribute{Name: "data-critical", Value: "true"}).Apply(img)
+	img.Apply(root)
+
+	plainImg := gutrees.NewElement("img", true)
+	(&gutrees.Attribute{Name: "src", Value: "/ignored.jpg"}).Apply(plainImg)
+	plainImg.Apply(root)
+
+	hints := components.AutoPreloadHints(root)
+	if len(hints) != 1 {
+		t.Fatalf("\t%s\t Should only preload the critical image, got %d hints", failed, len(hints))
+	}
+
+	href, _ := gutrees.GetAttr(hints[0], "href")
+	as, _ := gutrees.GetAttr(hints[0], "as")
+	if href.Value != "/hero.jpg" || as.Value != "image" {
+		t.Fatalf("\t%s\t Should preload the critical image as an image, got href=%+v as=%+v", failed, href, as)
+	}
+	t.Logf("\t%s\t Should generate a preload hint for an element marked data-critical", success)
+}
@@ -0,0 +1,45 @@
+package components
+
+import (
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// ImageSource describes one <source> candidate within a ResponsiveImage,
+// each field mapping directly to the matching source attribute when set.
+type ImageSource struct {
+	Media  string
+	Srcset string
+	Type   string
+}
+
+// ResponsiveImage renders a <picture> with one <source> per entry in
+// sources, followed by a fallback <img> carrying alt for accessibility and
+// the last source's srcset as its src so older browsers still get an image.
+func ResponsiveImage(alt string, sources []ImageSource) *gutrees.Element {
+	var children []gutrees.Appliable
+
+	var fallbackSrc string
+
+	for _, source := range sources {
+		var markup []gutrees.Appliable
+
+		if source.Media != "" {
+			markup = append(markup, attrs.Media(source.Media))
+		}
+		if source.Srcset != "" {
+			markup = append(markup, attrs.Srcset(source.Srcset))
+			fallbackSrc = source.Srcset
+		}
+		if source.Type != "" {
+			markup = append(markup, attrs.Type(source.Type))
+		}
+
+		children = append(children, elems.Source(markup...))
+	}
+
+	children = append(children, elems.Image(attrs.Src(fallbackSrc), attrs.Alt(alt)))
+
+	return elems.Picture(children...)
+}
@@ -0,0 +1,49 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/components"
+)
+
+func TestResponsiveImageStructure(t *testing.T) {
+	pic := components.ResponsiveImage("a mountain", []components.ImageSource{
+		{Media: "(min-width: 800px)", Srcset: "large.jpg", Type: "image/jpeg"},
+		{Media: "(max-width: 799px)", Srcset: "small.jpg", Type: "image/jpeg"},
+	})
+
+	if pic.Name() != "picture" {
+		t.Fatalf("\t%s\t Should render a <picture> root, got %q", failed, pic.Name())
+	}
+
+	children := pic.Children()
+	if len(children) != 3 {
+		t.Fatalf("\t%s\t Should have two sources and a fallback img, got %d children", failed, len(children))
+	}
+
+	source1 := children[0].(*gutrees.Element)
+	source2 := children[1].(*gutrees.Element)
+	img := children[2].(*gutrees.Element)
+
+	if source1.Name() != "source" || source2.Name() != "source" {
+		t.Fatalf("\t%s\t Should render <source> elements for each ImageSource", failed)
+	}
+	t.Logf("\t%s\t Should render <source> elements for each ImageSource", success)
+
+	if img.Name() != "img" {
+		t.Fatalf("\t%s\t Should render a fallback <img>, got %q", failed, img.Name())
+	}
+
+	alt, err := gutrees.GetAttr(img, "alt")
+	if err != nil || alt.Value != "a mountain" {
+		t.Fatalf("\t%s\t Should set alt on the fallback img", failed)
+	}
+	t.Logf("\t%s\t Should always set alt on the fallback img", success)
+
+	src, err := gutrees.GetAttr(img, "src")
+	if err != nil || src.Value != "small.jpg" {
+		t.Fatalf("\t%s\t Should set src on the fallback img from the last source, got %+v", failed, src)
+	}
+	t.Logf("\t%s\t Should set a usable src on the fallback img", success)
+}
@@ -0,0 +1,91 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// tocEntry is one heading collected while walking a document for
+// TableOfContents.
+type tocEntry struct {
+	level int
+	id    string
+	text  string
+}
+
+// tocNode nests a tocEntry under its ancestor heading, mirroring the
+// document's heading hierarchy before it's rendered into <ul>/<li>.
+type tocNode struct {
+	entry    tocEntry
+	children []*tocNode
+}
+
+// TableOfContents walks root and its descendants, collecting headings
+// (h1-h6) that carry an id - as SlugifyHeadings assigns - and builds a
+// nested <ul>/<li>/<a> list mirroring the heading hierarchy, each link
+// pointing at "#" + the heading's id. A heading that skips levels (an h3
+// directly under an h1, with no h2 between) nests one level under that
+// h1, as its direct child; no entry is added for the missing level.
+// Headings without an id are skipped, since there's nothing to link to.
+func TableOfContents(root *gutrees.Element) *gutrees.Element {
+	var roots []*tocNode
+	var stack []*tocNode
+
+	gutrees.Walk(root, func(e *gutrees.Element) bool {
+		level, ok := gutrees.HeadingLevel(e.Name())
+		if !ok {
+			return true
+		}
+		id, err := gutrees.GetAttr(e, "id")
+		if err != nil {
+			return true
+		}
+
+		node := &tocNode{entry: tocEntry{level: level, id: id.Value, text: headingText(e)}}
+
+		for len(stack) > 0 && stack[len(stack)-1].entry.level >= level {
+			stack = stack[:len(stack)-1]
+		}
+
+		if len(stack) == 0 {
+			roots = append(roots, node)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, node)
+		}
+		stack = append(stack, node)
+
+		return true
+	})
+
+	return buildTocList(roots)
+}
+
+// buildTocList renders nodes as a <ul> of <li><a>...</a></li>, recursing
+// into a nested <ul> for any node with children.
+func buildTocList(nodes []*tocNode) *gutrees.Element {
+	items := make([]gutrees.Appliable, 0, len(nodes))
+
+	for _, n := range nodes {
+		li := elems.ListItem(elems.Anchor(attrs.Href("#"+n.entry.id), elems.Text(n.entry.text)))
+		if len(n.children) > 0 {
+			buildTocList(n.children).Apply(li)
+		}
+		items = append(items, li)
+	}
+
+	return elems.UnorderedList(items...)
+}
+
+// headingText concatenates e's text-node descendants in document order,
+// the way a browser's textContent would.
+func headingText(e *gutrees.Element) string {
+	var parts []string
+	gutrees.WalkText(e, func(text *gutrees.Element) {
+		parts = append(parts, text.TextContent())
+	})
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,50 @@
+package components_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/components"
+)
+
+func headingWithID(tag, id, text string) *gutrees.Element {
+	e := gutrees.NewElement(tag, false)
+	(&gutrees.Attribute{Name: "id", Value: id}).Apply(e)
+	gutrees.NewText(text).Apply(e)
+	return e
+}
+
+func TestTableOfContentsNestsHeadingHierarchy(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+	headingWithID("h1", "intro", "Intro").Apply(root)
+	headingWithID("h2", "setup", "Setup").Apply(root)
+	headingWithID("h2", "usage", "Usage").Apply(root)
+	headingWithID("h3", "advanced", "Advanced").Apply(root)
+
+	toc := components.TableOfContents(root)
+	out := string(gutrees.RenderBytes(toc))
+
+	if strings.Count(out, "<ul") != 3 {
+		t.Fatalf("\t%s\t Should produce a two-level nested list (3 <ul> total), got %s", failed, out)
+	}
+	if !strings.Contains(out, `href="#intro"`) || !strings.Contains(out, `href="#advanced"`) {
+		t.Fatalf("\t%s\t Should link every collected heading, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should nest an h3-after-h1 two levels deep", success)
+}
+
+func TestTableOfContentsSkipsHeadingsWithoutID(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+	noID := gutrees.NewElement("h1", false)
+	gutrees.NewText("No ID").Apply(noID)
+	noID.Apply(root)
+
+	toc := components.TableOfContents(root)
+	out := string(gutrees.RenderBytes(toc))
+
+	if strings.Contains(out, "No ID") {
+		t.Fatalf("\t%s\t Should skip a heading with no id to link to, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should skip headings without an id", success)
+}
@@ -0,0 +1,45 @@
+package gutrees
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// gzipWriterPool reuses gzip.Writer values across requests to avoid a fresh
+// allocation on every render.
+var gzipWriterPool = sync.Pool{
+	New: func() interface{} { return gzip.NewWriter(nil) },
+}
+
+// RenderCompressed writes the rendered tree to w, gzip-compressing the
+// response and setting Content-Encoding when the request's Accept-Encoding
+// header advertises gzip support, and falling back to a plain write
+// otherwise.
+func RenderCompressed(w http.ResponseWriter, r *http.Request, e *Element) error {
+	body, err := SimpleMarkupWriter.Write(e)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+		_, err := io.WriteString(w, body)
+		return err
+	}
+
+	w.Header().Set("Content-Encoding", "gzip")
+
+	gz := gzipWriterPool.Get().(*gzip.Writer)
+	gz.Reset(w)
+	defer gzipWriterPool.Put(gz)
+
+	if _, err := io.WriteString(gz, body); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
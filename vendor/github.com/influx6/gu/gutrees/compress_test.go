@@ -0,0 +1,64 @@
+package gutrees_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestRenderCompressedGzipPath(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+
+	w := httptest.NewRecorder()
+
+	if err := gutrees.RenderCompressed(w, req, elems.Div(elems.Text("hello"))); err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	if w.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("\t%s\t Should set Content-Encoding: gzip", failed)
+	}
+	t.Logf("\t%s\t Should set Content-Encoding: gzip", success)
+
+	gz, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatalf("\t%s\t Should produce a valid gzip stream, got %s", failed, err)
+	}
+
+	out, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("\t%s\t Should decompress cleanly, got %s", failed, err)
+	}
+
+	if !strings.Contains(string(out), "hello") {
+		t.Fatalf("\t%s\t Should contain the rendered content, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should contain the rendered content once decompressed", success)
+}
+
+func TestRenderCompressedIdentityPath(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+
+	w := httptest.NewRecorder()
+
+	if err := gutrees.RenderCompressed(w, req, elems.Div(elems.Text("hello"))); err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	if w.Header().Get("Content-Encoding") != "" {
+		t.Fatalf("\t%s\t Should not set Content-Encoding when gzip is unsupported", failed)
+	}
+	t.Logf("\t%s\t Should not set Content-Encoding when gzip is unsupported", success)
+
+	if !strings.Contains(w.Body.String(), "hello") {
+		t.Fatalf("\t%s\t Should contain the rendered content plainly, got %s", failed, w.Body.String())
+	}
+	t.Logf("\t%s\t Should contain the rendered content plainly", success)
+}
+
@@ -0,0 +1,114 @@
+package gutrees
+
+import "sync"
+
+// *Element is not safe for concurrent mutation by default - AddChild,
+// Empty and friends read and write its children/attrs/styles slices with
+// no locking, so filling one shared tree from multiple goroutines (e.g.
+// one per section, each populated by its own data fetch) races. Wrap the
+// root with NewConcurrentElement to opt into safe concurrent building
+// instead of hand-rolling a mutex around every call site.
+
+// ConcurrentElement wraps an *Element with a mutex guarding its mutating
+// methods: AddChild, Apply, Empty, RemoveAttr, RemoveStyle, SetTagName,
+// SetData, Remove, Clean and Freeze. Reads (Children, Attributes,
+// rendering, ...) still go straight to the embedded *Element and aren't
+// safe to run concurrently with a write.
+//
+// *Element has grown mutating methods this type doesn't wrap yet -
+// SwapUID, SwapHash, UpdateHash and Reconcile - and being an embedded
+// *Element, they're still directly callable on a ConcurrentElement with
+// no locking at all. Go back and forth to the data these touch (uid,
+// hash, the child list during a diff) only if nothing else is
+// concurrently mutating the same element.
+type ConcurrentElement struct {
+	mu sync.Mutex
+	*Element
+}
+
+// NewConcurrentElement wraps e so the mutating methods ConcurrentElement
+// wraps are safe to call against the returned value from multiple
+// goroutines.
+func NewConcurrentElement(e *Element) *ConcurrentElement {
+	return &ConcurrentElement{Element: e}
+}
+
+// AddChild adds children to the wrapped element while holding its lock.
+func (c *ConcurrentElement) AddChild(em ...Markup) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Element.AddChild(em...)
+}
+
+// Apply runs each of appliers against the wrapped element while holding
+// its lock, so e.g. concurrent goroutines calling
+// concurrentElement.Apply(attrs.Class("x")) don't race with each other or
+// with a concurrent AddChild.
+func (c *ConcurrentElement) Apply(appliers ...Appliable) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, a := range appliers {
+		a.Apply(c.Element)
+	}
+}
+
+// Empty clears the wrapped element's children while holding its lock.
+func (c *ConcurrentElement) Empty() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Element.Empty()
+}
+
+// RemoveAttr removes the attribute matching name from the wrapped
+// element while holding its lock.
+func (c *ConcurrentElement) RemoveAttr(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Element.RemoveAttr(name)
+}
+
+// RemoveStyle removes the style property matching prop from the wrapped
+// element while holding its lock.
+func (c *ConcurrentElement) RemoveStyle(prop string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Element.RemoveStyle(prop)
+}
+
+// SetTagName changes the wrapped element's tag name and autoclose flag
+// while holding its lock.
+func (c *ConcurrentElement) SetTagName(tag string, autoclose bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Element.SetTagName(tag, autoclose)
+}
+
+// SetData sets a "data-*" attribute on the wrapped element while holding
+// its lock.
+func (c *ConcurrentElement) SetData(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Element.SetData(key, value)
+}
+
+// Remove marks the wrapped element as removed while holding its lock.
+func (c *ConcurrentElement) Remove() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Element.Remove()
+}
+
+// Clean removes the wrapped element's removed-marked descendants while
+// holding its lock.
+func (c *ConcurrentElement) Clean() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Element.Clean()
+}
+
+// Freeze freezes the wrapped element while holding its lock.
+func (c *ConcurrentElement) Freeze() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Element.Freeze()
+}
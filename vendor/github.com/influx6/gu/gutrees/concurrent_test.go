@@ -0,0 +1,71 @@
+package gutrees_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestConcurrentElementSurvivesConcurrentAddChild(t *testing.T) {
+	root := gutrees.NewConcurrentElement(gutrees.NewElement("ul", false))
+
+	var wg sync.WaitGroup
+	const goroutines = 50
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			root.AddChild(gutrees.NewElement("li", false))
+		}()
+	}
+
+	wg.Wait()
+
+	if got := len(root.Children()); got != goroutines {
+		t.Fatalf("\t%s\t Should end up with one child per goroutine, got %d", failed, got)
+	}
+	t.Logf("\t%s\t Should add every child safely under concurrent AddChild calls", success)
+}
+
+func TestConcurrentElementSurvivesConcurrentApply(t *testing.T) {
+	root := gutrees.NewConcurrentElement(gutrees.NewElement("div", false))
+
+	var wg sync.WaitGroup
+	const goroutines = 50
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			root.Apply(&gutrees.Attribute{Name: "data-seen", Value: "x"})
+		}(i)
+	}
+
+	wg.Wait()
+
+	if _, err := gutrees.GetAttr(root.Element, "data-seen"); err != nil {
+		t.Fatalf("\t%s\t Should have applied the attribute, got err %s", failed, err)
+	}
+	t.Logf("\t%s\t Should apply safely under concurrent Apply calls", success)
+}
+
+func TestConcurrentElementSurvivesConcurrentSetDataAndRemoveAttr(t *testing.T) {
+	root := gutrees.NewConcurrentElement(gutrees.NewElement("div", false))
+
+	var wg sync.WaitGroup
+	const goroutines = 50
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			root.SetData("seen", "x")
+			root.RemoveAttr("data-seen")
+		}()
+	}
+
+	wg.Wait()
+	t.Logf("\t%s\t Should set and remove data attributes safely under concurrent calls", success)
+}
@@ -0,0 +1,63 @@
+package gutrees
+
+// Context provides simple typed value storage for scoping values (theme,
+// locale, current user) down a render tree without threading them through
+// every constructor, loosely modeled on React's context pattern adapted to
+// this build-time tree.
+type Context struct {
+	values map[string]interface{}
+}
+
+// NewContext returns a new empty Context.
+func NewContext() *Context {
+	return &Context{values: make(map[string]interface{})}
+}
+
+// Set stores a value under the given key.
+func (c *Context) Set(key string, val interface{}) {
+	c.values[key] = val
+}
+
+// Get reads the value stored under the given key.
+func (c *Context) Get(key string) (interface{}, bool) {
+	val, ok := c.values[key]
+	return val, ok
+}
+
+// ctxStack tracks the Contexts currently in scope for the synchronous render
+// pass in progress, innermost last.
+var ctxStack []*Context
+
+// contextProvider scopes a Context to the markup applied through it.
+type contextProvider struct {
+	ctx    *Context
+	markup []Appliable
+}
+
+// Apply pushes the provider's Context, applies the wrapped markup (which may
+// itself contain components that read CurrentContext during Render), then
+// pops it back off once the subtree has been built.
+func (c contextProvider) Apply(m Markup) {
+	ctxStack = append(ctxStack, c.ctx)
+
+	for _, mm := range c.markup {
+		mm.Apply(m)
+	}
+
+	ctxStack = ctxStack[:len(ctxStack)-1]
+}
+
+// WithContext scopes ctx to the given markup, making it discoverable via
+// CurrentContext to any component rendered within that subtree.
+func WithContext(ctx *Context, markup ...Appliable) Appliable {
+	return contextProvider{ctx: ctx, markup: markup}
+}
+
+// CurrentContext returns the nearest enclosing Context active during the
+// synchronous render pass in progress, or nil if none has been provided.
+func CurrentContext() *Context {
+	if len(ctxStack) == 0 {
+		return nil
+	}
+	return ctxStack[len(ctxStack)-1]
+}
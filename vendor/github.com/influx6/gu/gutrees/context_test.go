@@ -0,0 +1,35 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+type themedLabel struct{}
+
+func (themedLabel) Render() *gutrees.Element {
+	theme := "light"
+	if ctx := gutrees.CurrentContext(); ctx != nil {
+		if val, ok := ctx.Get("theme"); ok {
+			theme = val.(string)
+		}
+	}
+	return elems.Text(theme)
+}
+
+func TestContextReadsNearestProvider(t *testing.T) {
+	ctx := gutrees.NewContext()
+	ctx.Set("theme", "dark")
+
+	root := elems.Div(
+		gutrees.WithContext(ctx, gutrees.Component(themedLabel{})),
+	)
+
+	label := root.Children()[0].(*gutrees.Element)
+	if label.TextContent() != "dark" {
+		t.Fatalf("\t%s\t Should have read 'dark' theme from the ancestor provider, got %q", failed, label.TextContent())
+	}
+	t.Logf("\t%s\t Should have read the theme set by the ancestor provider", success)
+}
@@ -0,0 +1,105 @@
+// Package css provides a small builder for emitting `<style>` block content,
+// complementing the inline styles produced by the styles package.
+package css
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"strings"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+// sanitize strips characters that would allow content to break out of the
+// style context it's rendered into.
+func sanitize(val string) string {
+	r := strings.NewReplacer("{", "", "}", "", "<", "", ">", "", "\"", "")
+	return r.Replace(val)
+}
+
+// Rule represents a single css selector and its declarations.
+type Rule struct {
+	selector string
+	props    []*gutrees.Style
+}
+
+// NewRule returns a new Rule for the given selector, built from the supplied
+// style properties (reusing the constructors in the styles package).
+func NewRule(selector string, props ...*gutrees.Style) *Rule {
+	return &Rule{
+		selector: sanitize(selector),
+		props:    props,
+	}
+}
+
+// Render writes out the rule as a `selector { prop:value; }` css block.
+func (r *Rule) Render(minify bool) string {
+	var decls []string
+	for _, p := range r.props {
+		decls = append(decls, fmt.Sprintf("%s:%s;", sanitize(p.Name), sanitize(p.Value)))
+	}
+
+	body := strings.Join(decls, " ")
+
+	if minify {
+		return fmt.Sprintf("%s{%s}", r.selector, strings.Join(decls, ""))
+	}
+
+	return fmt.Sprintf("%s {\n  %s\n}\n", r.selector, body)
+}
+
+// Sheet represents a collection of css rules renderable into a `<style>` block.
+type Sheet struct {
+	rules  []*Rule
+	minify bool
+}
+
+// NewSheet returns a new Sheet composed of the given rules.
+func NewSheet(rules ...*Rule) *Sheet {
+	return &Sheet{rules: rules}
+}
+
+// Minify marks the sheet to render without the extra whitespace/newlines used
+// for readability, useful for production output.
+func (s *Sheet) Minify() *Sheet {
+	s.minify = true
+	return s
+}
+
+// String renders the sheet into css text suitable for elems.Style.
+func (s *Sheet) String() string {
+	var out []string
+	for _, r := range s.rules {
+		out = append(out, r.Render(s.minify))
+	}
+
+	if s.minify {
+		return strings.Join(out, "")
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// Scope generates a deterministic, content-hashed class name for a component,
+// rewrites the given rules' selectors to use it and returns the resulting
+// stylesheet along with a Class attribute applier carrying the same name, so
+// elements and rules stay in sync (the CSS-modules pattern). The hash is
+// derived only from the rules' own content, so the same rules always
+// generate the same class on both server and client.
+func Scope(prefix string, rules ...*Rule) (*Sheet, *gutrees.Attribute) {
+	var content strings.Builder
+	for _, r := range rules {
+		content.WriteString(r.Render(true))
+	}
+
+	sum := sha1.Sum([]byte(content.String()))
+	class := fmt.Sprintf("%s-%x", sanitize(prefix), sum[:6])
+
+	scoped := make([]*Rule, len(rules))
+	for i, r := range rules {
+		scoped[i] = &Rule{selector: "." + class, props: r.props}
+	}
+
+	return NewSheet(scoped...), attrs.Class(class)
+}
@@ -0,0 +1,53 @@
+package css_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees/css"
+	"github.com/influx6/gu/gutrees/styles"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestSheetRendersTwoRules(t *testing.T) {
+	sheet := css.NewSheet(
+		css.NewRule(".box", styles.Color("red"), styles.Display("block")),
+		css.NewRule(".panel", styles.Width(styles.Px(200))),
+	)
+
+	out := sheet.String()
+
+	if !strings.Contains(out, ".box") || !strings.Contains(out, ".panel") {
+		t.Fatalf("\t%s\t Should contain both rule selectors but got: %s", failed, out)
+	}
+	t.Logf("\t%s\t Should contain both rule selectors", success)
+
+	if !strings.Contains(out, "color:red;") || !strings.Contains(out, "width:200px;") {
+		t.Fatalf("\t%s\t Should contain the declared properties but got: %s", failed, out)
+	}
+	t.Logf("\t%s\t Should contain the declared properties", success)
+}
+
+func TestScopeIsDeterministic(t *testing.T) {
+	rule := css.NewRule(".root", styles.Color("blue"))
+
+	sheetA, classA := css.Scope("btn", rule)
+	sheetB, classB := css.Scope("btn", rule)
+
+	if classA.Value != classB.Value {
+		t.Fatalf("\t%s\t Should generate the same class for identical input, got %q and %q", failed, classA.Value, classB.Value)
+	}
+	t.Logf("\t%s\t Should generate the same class for identical input", success)
+
+	if !strings.Contains(sheetA.String(), "."+classA.Value) {
+		t.Fatalf("\t%s\t Should rewrite the rule selector to match the generated class but got: %s", failed, sheetA.String())
+	}
+	t.Logf("\t%s\t Should rewrite the rule selector to match the generated class", success)
+
+	if sheetA.String() != sheetB.String() {
+		t.Fatalf("\t%s\t Should produce identical stylesheets for identical input", failed)
+	}
+	t.Logf("\t%s\t Should produce identical stylesheets for identical input", success)
+}
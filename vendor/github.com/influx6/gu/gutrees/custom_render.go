@@ -0,0 +1,29 @@
+package gutrees
+
+import "io"
+
+// CustomRenderer lets a node provide its own serialization, bypassing
+// the renderer's normal tag/attribute/children machinery entirely - for
+// embedding raw or specially-formatted content (e.g. a chart widget's
+// pre-rendered markup) as a first-class tree node, rather than writing
+// it through NewText, which would escape it as plain text.
+type CustomRenderer interface {
+	RenderSelf(w io.Writer) error
+}
+
+// CustomRender returns an Appliable that renders as whatever r.RenderSelf
+// writes, instead of the usual tag serialization.
+func CustomRender(r CustomRenderer) Appliable {
+	return &customRenderApplier{r: r}
+}
+
+type customRenderApplier struct {
+	r CustomRenderer
+}
+
+// Apply adds a placeholder element carrying r onto m.
+func (c *customRenderApplier) Apply(m Markup) {
+	e := NewElement("custom-render", false)
+	e.customRenderer = c.r
+	e.Apply(m)
+}
@@ -0,0 +1,42 @@
+package gutrees_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+type fixedRaw string
+
+func (f fixedRaw) RenderSelf(w io.Writer) error {
+	_, err := io.WriteString(w, string(f))
+	return err
+}
+
+func TestCustomRenderReplacesTagSerialization(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+	gutrees.CustomRender(fixedRaw("<svg-chart data-id=\"1\"/>")).Apply(root)
+
+	out := string(gutrees.RenderBytes(root))
+	if !bytes.Contains([]byte(out), []byte(`<svg-chart data-id="1"/>`)) {
+		t.Fatalf("\t%s\t Should render the CustomRenderer's raw output, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should bypass tag serialization for a custom-rendered node", success)
+}
+
+func TestCustomRenderStreamsSameOutputAsBuffered(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+	gutrees.CustomRender(fixedRaw("<raw/>")).Apply(root)
+
+	var buf bytes.Buffer
+	if err := gutrees.RenderStreaming(&buf, root, nil); err != nil {
+		t.Fatalf("\t%s\t Should stream without error, got %s", failed, err)
+	}
+
+	if buf.String() != string(gutrees.RenderBytes(root)) {
+		t.Fatalf("\t%s\t Should match buffered render, got %q", failed, buf.String())
+	}
+	t.Logf("\t%s\t Should stream the CustomRenderer's output identically to Print", success)
+}
@@ -0,0 +1,58 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestApplyIntoOwnSubtreePanics(t *testing.T) {
+	parent := elems.Div()
+	child := elems.Span()
+	child.Apply(parent)
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("\t%s\t Should panic when applying an ancestor into its own subtree", failed)
+		}
+		if _, ok := r.(*gutrees.CycleError); !ok {
+			t.Fatalf("\t%s\t Should panic with a *CycleError, got %T: %v", failed, r, r)
+		}
+		t.Logf("\t%s\t Should panic with a *CycleError when applying an ancestor into its own subtree", success)
+	}()
+
+	// parent is already child's ancestor; applying it back into child
+	// would make parent its own descendant.
+	parent.Apply(child)
+}
+
+func TestApplySelfPanics(t *testing.T) {
+	e := elems.Div()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("\t%s\t Should panic when applying an element to itself", failed)
+		}
+		if _, ok := r.(*gutrees.CycleError); !ok {
+			t.Fatalf("\t%s\t Should panic with a *CycleError, got %T: %v", failed, r, r)
+		}
+		t.Logf("\t%s\t Should panic with a *CycleError when applying an element to itself", success)
+	}()
+
+	e.Apply(e)
+}
+
+func TestApplyUnrelatedElementsDoesNotPanic(t *testing.T) {
+	parent := elems.Div()
+	child := elems.Span()
+
+	child.Apply(parent)
+
+	if child.Parent() != parent {
+		t.Fatalf("\t%s\t Should record parent as child's parent after Apply", failed)
+	}
+	t.Logf("\t%s\t Should apply unrelated elements and track the parent pointer without panicking", success)
+}
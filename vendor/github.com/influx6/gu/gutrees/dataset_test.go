@@ -0,0 +1,36 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestDatasetRoundTripsCamelCaseKey(t *testing.T) {
+	tree := elems.Div()
+	tree.SetData("userId", "42")
+
+	data := tree.Dataset()
+	if data["userId"] != "42" {
+		t.Fatalf("\t%s\t Should round-trip data-user-id as userId, got %+v", failed, data)
+	}
+
+	attr, err := gutrees.GetAttr(tree, "data-user-id")
+	if err != nil || attr.Value != "42" {
+		t.Fatalf("\t%s\t Should set the attribute as data-user-id, got %q (err=%v)", failed, attr, err)
+	}
+	t.Logf("\t%s\t Should round-trip a data-* attribute through Dataset/SetData", success)
+}
+
+func TestDatasetIgnoresNonDataAttributes(t *testing.T) {
+	tree := elems.Div()
+	tree.SetData("itemId", "7")
+	(&gutrees.Attribute{Name: "role", Value: "listitem"}).Apply(tree)
+
+	data := tree.Dataset()
+	if len(data) != 1 || data["itemId"] != "7" {
+		t.Fatalf("\t%s\t Should only include data-* attributes, got %+v", failed, data)
+	}
+	t.Logf("\t%s\t Should include only data-* attributes in Dataset", success)
+}
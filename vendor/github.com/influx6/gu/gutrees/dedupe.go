@@ -0,0 +1,70 @@
+package gutrees
+
+// DedupeHeadResources walks root looking for a <head> element and removes
+// duplicate resource-loading children within it, keeping the first
+// occurrence of each: a <link> is identified by its "href", a <script>
+// by its "src", and an inline <style> (no "src") by a hash of its
+// content. Components that each inject their own copy of the same
+// stylesheet or script balloon the head with repeats a browser has to
+// fetch or parse again for no benefit - this collapses them back down to
+// one.
+func DedupeHeadResources(root *Element) {
+	Walk(root, func(e *Element) bool {
+		if e.Name() != "head" {
+			return true
+		}
+
+		if e.rejectIfFrozen("DedupeHeadResources") {
+			return false
+		}
+
+		seen := make(map[string]bool)
+		var kept []Markup
+
+		for _, ch := range e.children {
+			ech, ok := ch.(*Element)
+			if !ok {
+				kept = append(kept, ch)
+				continue
+			}
+
+			key, dedupable := headResourceKey(ech)
+			if !dedupable {
+				kept = append(kept, ch)
+				continue
+			}
+
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			kept = append(kept, ch)
+		}
+
+		e.children = kept
+		return false
+	})
+}
+
+// headResourceKey returns the identity DedupeHeadResources uses for e,
+// and whether e is a kind of element it dedupes at all.
+func headResourceKey(e *Element) (string, bool) {
+	switch e.Name() {
+	case "link":
+		if href, err := GetAttr(e, "href"); err == nil {
+			return "link:" + href.Value, true
+		}
+		return "", false
+
+	case "script":
+		if src, err := GetAttr(e, "src"); err == nil {
+			return "script:" + src.Value, true
+		}
+		return "", false
+
+	case "style":
+		return "style:" + RenderHash(e), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,75 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestDedupeHeadResourcesCollapsesIdenticalInlineStyles(t *testing.T) {
+	head := gutrees.NewElement("head", false)
+
+	styleOne := gutrees.NewElement("style", false)
+	gutrees.NewText("body { margin: 0; }").Apply(styleOne)
+	styleOne.Apply(head)
+
+	styleTwo := gutrees.NewElement("style", false)
+	gutrees.NewText("body { margin: 0; }").Apply(styleTwo)
+	styleTwo.Apply(head)
+
+	gutrees.DedupeHeadResources(head)
+
+	children := head.Children()
+	if len(children) != 1 {
+		t.Fatalf("\t%s\t Should collapse two identical inline styles into one, got %d children", failed, len(children))
+	}
+	t.Logf("\t%s\t Should collapse identical inline <style> blocks down to one", success)
+}
+
+func TestDedupeHeadResourcesKeepsDistinctResources(t *testing.T) {
+	head := gutrees.NewElement("head", false)
+
+	linkOne := gutrees.NewElement("link", true)
+	(&gutrees.Attribute{Name: "href", Value: "/a.css"}).Apply(linkOne)
+	linkOne.Apply(head)
+
+	linkDupe := gutrees.NewElement("link", true)
+	(&gutrees.Attribute{Name: "href", Value: "/a.css"}).Apply(linkDupe)
+	linkDupe.Apply(head)
+
+	linkTwo := gutrees.NewElement("link", true)
+	(&gutrees.Attribute{Name: "href", Value: "/b.css"}).Apply(linkTwo)
+	linkTwo.Apply(head)
+
+	scriptOne := gutrees.NewElement("script", false)
+	(&gutrees.Attribute{Name: "src", Value: "/app.js"}).Apply(scriptOne)
+	scriptOne.Apply(head)
+
+	scriptDupe := gutrees.NewElement("script", false)
+	(&gutrees.Attribute{Name: "src", Value: "/app.js"}).Apply(scriptDupe)
+	scriptDupe.Apply(head)
+
+	gutrees.DedupeHeadResources(head)
+
+	children := head.Children()
+	if len(children) != 3 {
+		t.Fatalf("\t%s\t Should keep the first of each duplicate and all distinct resources, got %d children", failed, len(children))
+	}
+
+	hrefs := map[string]bool{}
+	srcs := map[string]bool{}
+	for _, ch := range children {
+		e := ch.(*gutrees.Element)
+		if href, err := gutrees.GetAttr(e, "href"); err == nil {
+			hrefs[href.Value] = true
+		}
+		if src, err := gutrees.GetAttr(e, "src"); err == nil {
+			srcs[src.Value] = true
+		}
+	}
+
+	if !hrefs["/a.css"] || !hrefs["/b.css"] || !srcs["/app.js"] {
+		t.Fatalf("\t%s\t Should keep one of each distinct link/script, got hrefs=%+v srcs=%+v", failed, hrefs, srcs)
+	}
+	t.Logf("\t%s\t Should keep distinct links and scripts, deduping only exact matches", success)
+}
@@ -0,0 +1,83 @@
+package gutrees_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// deeplyNested builds a chain of n+1 nested <div> elements (n wrappers
+// around one leaf), innermost first.
+func deeplyNested(n int) *gutrees.Element {
+	leaf := elems.Div()
+	for i := 0; i < n; i++ {
+		leaf = elems.Div(leaf)
+	}
+	return leaf
+}
+
+func TestRenderStreamingFailsPastMaxDepth(t *testing.T) {
+	var buf bytes.Buffer
+	err := gutrees.RenderStreaming(&buf, deeplyNested(gutrees.DefaultMaxDepth+10), nil)
+	if err != gutrees.ErrMaxDepthExceeded {
+		t.Fatalf("\t%s\t Should fail with ErrMaxDepthExceeded past the default depth, got %v", failed, err)
+	}
+	t.Logf("\t%s\t Should refuse to render past the default max depth", success)
+}
+
+func TestRenderStreamingWithRespectsCustomMaxDepth(t *testing.T) {
+	ew := gutrees.NewElementWriter(gutrees.SimpleAttrWriter, gutrees.SimpleStyleWriter, gutrees.SimpleTextWriter)
+	ew.SetMaxDepth(5)
+
+	var buf bytes.Buffer
+	err := gutrees.RenderStreamingWith(&buf, ew, deeplyNested(10), nil)
+	if err != gutrees.ErrMaxDepthExceeded {
+		t.Fatalf("\t%s\t Should fail with ErrMaxDepthExceeded past a custom max depth of 5, got %v", failed, err)
+	}
+	t.Logf("\t%s\t Should enforce a custom max depth set via SetMaxDepth", success)
+}
+
+func TestRenderStreamingWithAcceptsTreeUnderCustomMaxDepth(t *testing.T) {
+	ew := gutrees.NewElementWriter(gutrees.SimpleAttrWriter, gutrees.SimpleStyleWriter, gutrees.SimpleTextWriter)
+	ew.SetMaxDepth(20)
+
+	var buf bytes.Buffer
+	if err := gutrees.RenderStreamingWith(&buf, ew, deeplyNested(10), nil); err != nil {
+		t.Fatalf("\t%s\t Should render a tree under its custom max depth without error, got %v", failed, err)
+	}
+	t.Logf("\t%s\t Should leave a tree under its custom max depth unaffected", success)
+}
+
+func TestWalkDepthLimitedFailsPastLimit(t *testing.T) {
+	var visited int
+	err := gutrees.WalkDepthLimited(deeplyNested(50), 10, func(e *gutrees.Element) bool {
+		visited++
+		return true
+	})
+
+	if err != gutrees.ErrMaxDepthExceeded {
+		t.Fatalf("\t%s\t Should fail with ErrMaxDepthExceeded past maxDepth, got %v", failed, err)
+	}
+	if visited == 0 {
+		t.Fatalf("\t%s\t Should have visited elements up to the limit before failing", failed)
+	}
+	t.Logf("\t%s\t Should stop a walk that nests past its configured limit", success)
+}
+
+func TestWalkDepthLimitedAcceptsShallowTree(t *testing.T) {
+	var visited int
+	err := gutrees.WalkDepthLimited(deeplyNested(5), 10, func(e *gutrees.Element) bool {
+		visited++
+		return true
+	})
+
+	if err != nil {
+		t.Fatalf("\t%s\t Should walk a tree under the limit without error, got %v", failed, err)
+	}
+	if visited != 6 {
+		t.Fatalf("\t%s\t Should visit every element in the tree, got %d want 6", failed, visited)
+	}
+	t.Logf("\t%s\t Should walk a tree under its depth limit to completion", success)
+}
@@ -0,0 +1,111 @@
+package gutrees
+
+// PatchOp identifies the kind of change a Patch describes.
+type PatchOp string
+
+// Available PatchOp kinds.
+const (
+	// PatchReplace swaps the node at Path for the element described by HTML -
+	// its tag, or the whole subtree, differs too much to patch in place.
+	PatchReplace PatchOp = "replace"
+
+	// PatchAttrs updates the attributes of the node at Path to Attrs.
+	PatchAttrs PatchOp = "attrs"
+
+	// PatchText updates the text content of the text node at Path to HTML.
+	PatchText PatchOp = "text"
+
+	// PatchInsert adds the element described by HTML as a new child at Path.
+	PatchInsert PatchOp = "insert"
+
+	// PatchRemove deletes the child at Path.
+	PatchRemove PatchOp = "remove"
+)
+
+// Patch describes one change needed to turn an old tree into a new one.
+// Path addresses the affected node as a child-index array counted from
+// the diffed root, e.g. []int{0, 2} means "the third child of the first
+// child of root" - matching the position-based identity Reconcile already
+// uses elsewhere in this package.
+type Patch struct {
+	Op    PatchOp
+	Path  []int
+	Attrs map[string]string
+	HTML  string
+}
+
+// Diff compares oldEl against newEl and returns the Patches needed to
+// turn the former into the latter, for sending the result of a
+// server-side re-render down to a client as a diff instead of a full
+// document. It's a positional diff - children are compared by index, not
+// matched by identity or key - so reordering children produces
+// replace/insert/remove patches rather than a move, the same tradeoff
+// Reconcile makes.
+func Diff(oldEl, newEl *Element) []Patch {
+	return diffAt(oldEl, newEl, nil)
+}
+
+func diffAt(oldEl, newEl *Element, path []int) []Patch {
+	if oldEl.Name() != newEl.Name() {
+		return []Patch{{Op: PatchReplace, Path: path, HTML: string(RenderBytes(newEl))}}
+	}
+
+	var patches []Patch
+
+	if oldEl.Name() == "text" {
+		if oldEl.TextContent() != newEl.TextContent() {
+			patches = append(patches, Patch{Op: PatchText, Path: path, HTML: newEl.TextContent()})
+		}
+		return patches
+	}
+
+	if changed := diffAttrs(oldEl, newEl); changed != nil {
+		patches = append(patches, Patch{Op: PatchAttrs, Path: path, Attrs: changed})
+	}
+
+	oldChildren := childElements(oldEl)
+	newChildren := childElements(newEl)
+
+	max := len(oldChildren)
+	if len(newChildren) > max {
+		max = len(newChildren)
+	}
+
+	for i := 0; i < max; i++ {
+		childPath := append(append([]int{}, path...), i)
+
+		switch {
+		case i >= len(oldChildren):
+			patches = append(patches, Patch{Op: PatchInsert, Path: childPath, HTML: string(RenderBytes(newChildren[i]))})
+		case i >= len(newChildren):
+			patches = append(patches, Patch{Op: PatchRemove, Path: childPath})
+		default:
+			patches = append(patches, diffAt(oldChildren[i], newChildren[i], childPath)...)
+		}
+	}
+
+	return patches
+}
+
+// diffAttrs returns the attributes of newEl that are missing or have a
+// different value on oldEl, or nil if newEl's attributes already all
+// match. It doesn't report attributes present on oldEl but removed on
+// newEl - a patch consumer that needs exact parity should re-derive
+// attributes from a PatchReplace instead.
+func diffAttrs(oldEl, newEl *Element) map[string]string {
+	var changed map[string]string
+
+	for _, a := range newEl.Attributes() {
+		old, err := GetAttr(oldEl, a.Name)
+		if err == nil && old.Value == a.Value {
+			continue
+		}
+
+		if changed == nil {
+			changed = make(map[string]string)
+		}
+		changed[a.Name] = a.Value
+	}
+
+	return changed
+}
@@ -0,0 +1,52 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func buildDivWithID(id string) *gutrees.Element {
+	div := gutrees.NewElement("div", false)
+	(&gutrees.Attribute{Name: "id", Value: id}).Apply(div)
+	return div
+}
+
+func TestDiffDetectsAttributeChange(t *testing.T) {
+	patches := gutrees.Diff(buildDivWithID("a"), buildDivWithID("b"))
+
+	if len(patches) != 1 || patches[0].Op != gutrees.PatchAttrs {
+		t.Fatalf("\t%s\t Should produce a single attrs patch for a changed attribute, got %+v", failed, patches)
+	}
+	if patches[0].Attrs["id"] != "b" {
+		t.Fatalf("\t%s\t Should carry the new attribute value, got %+v", failed, patches[0].Attrs)
+	}
+	t.Logf("\t%s\t Should diff a changed attribute into a single PatchAttrs", success)
+}
+
+func TestDiffDetectsAddedAndRemovedChildren(t *testing.T) {
+	oldRoot := gutrees.NewElement("ul", false)
+	gutrees.NewElement("li", false).Apply(oldRoot)
+
+	newRoot := gutrees.NewElement("ul", false)
+	gutrees.NewElement("li", false).Apply(newRoot)
+	gutrees.NewElement("li", false).Apply(newRoot)
+
+	patches := gutrees.Diff(oldRoot, newRoot)
+	if len(patches) != 1 || patches[0].Op != gutrees.PatchInsert {
+		t.Fatalf("\t%s\t Should produce an insert patch for a new child, got %+v", failed, patches)
+	}
+	if len(patches[0].Path) != 1 || patches[0].Path[0] != 1 {
+		t.Fatalf("\t%s\t Should address the inserted child by its index, got %+v", failed, patches[0].Path)
+	}
+	t.Logf("\t%s\t Should diff an added child into an insert patch addressed by index", success)
+}
+
+func TestDiffDetectsTagChangeAsReplace(t *testing.T) {
+	patches := gutrees.Diff(gutrees.NewElement("div", false), gutrees.NewElement("span", false))
+
+	if len(patches) != 1 || patches[0].Op != gutrees.PatchReplace {
+		t.Fatalf("\t%s\t Should produce a replace patch when the tag itself changes, got %+v", failed, patches)
+	}
+	t.Logf("\t%s\t Should diff a tag change into a replace patch", success)
+}
@@ -2,7 +2,9 @@ package gutrees
 
 import (
 	"fmt"
+	"log"
 	"strings"
+	"unicode"
 
 	"github.com/influx6/gu/guevents"
 )
@@ -29,11 +31,30 @@ type Element struct {
 	hash            string
 	tagname         string
 	textContent     string
+	frozen          bool
+	parent          *Element
 	events          []*Event
 	styles          []*Style
 	attrs           []*Attribute
+	attrIndex       map[string]int
 	children        []Markup
 	eventManager    guevents.EventManagers
+	lazyFn          func() *Element
+	lazyResult      *Element
+	origin          string
+	customRenderer  CustomRenderer
+}
+
+// reindexAttrs rebuilds attrIndex from attrs, the name->position map that
+// lets Attribute.Apply find an existing attribute without a linear scan
+// (RemoveAttr still scans attrs, then calls this). It's cheap
+// enough to call after any structural change to attrs (removal,
+// cloning) rather than trying to patch the index in place.
+func (e *Element) reindexAttrs() {
+	e.attrIndex = make(map[string]int, len(e.attrs))
+	for i, a := range e.attrs {
+		e.attrIndex[a.Name] = i
+	}
 }
 
 // NewText returns a new Text instance element
@@ -47,6 +68,21 @@ func NewText(txt string) *Element {
 	return em
 }
 
+// NewFragment returns an element that groups multiple children without
+// contributing a wrapping tag of its own when rendered, for components whose
+// Render produces more than one top-level node.
+func NewFragment(children ...Appliable) *Element {
+	e := NewElement("fragment", false)
+	e.allowAttributes = false
+	e.allowStyles = false
+
+	for _, c := range children {
+		c.Apply(e)
+	}
+
+	return e
+}
+
 // NewElement returns a new element instance giving the specificed name
 func NewElement(tag string, hasNoEndingTag bool) *Element {
 	return &Element{
@@ -61,6 +97,7 @@ func NewElement(tag string, hasNoEndingTag bool) *Element {
 		allowStyles:     true,
 		allowAttributes: true,
 		allowEvents:     true,
+		origin:          captureOrigin(2),
 	}
 }
 
@@ -69,6 +106,16 @@ func (e *Element) AutoClosed() bool {
 	return e.autoclose
 }
 
+// IsVoid reports whether e is a void element - one with no closing tag
+// and no content model, like <br> or <img> - as set by the
+// hasNoEndingTag argument to NewElement. It's the same flag AutoClosed
+// reports, under the HTML spec's own name for it, for callers working in
+// terms of content-model rules (a renderer option, a validator, an
+// InnerHTML getter) rather than tag-serialization style.
+func (e *Element) IsVoid() bool {
+	return e.autoclose
+}
+
 //==============================================================================
 
 // Eventers provide an interface type for elements able to register and load
@@ -110,9 +157,16 @@ func (e *Element) LoadEvents() {
 
 	}
 
-	//load up the children events also
+	//load up the children events also, skipping into <template> subtrees:
+	//their contents are inert document-fragment content until cloned out by
+	//script, so wiring up live event managers against them would attach
+	//handlers to nodes that were never actually mounted.
 	for _, em := range e.children {
 		if ech, ok := em.(ElementalMarkup); ok {
+			if ech.Name() == "template" {
+				continue
+			}
+
 			if !ech.UseEventManager(e.eventManager) {
 				ech.LoadEvents()
 			}
@@ -146,6 +200,27 @@ func (e *Element) Name() string {
 	return e.tagname
 }
 
+// TagName returns the tag name of the element. It's the same value Name
+// reports, under the name a caller renaming a tag via SetTagName is more
+// likely to look for.
+func (e *Element) TagName() string {
+	return e.tagname
+}
+
+// SetTagName renames e to tag and updates its autoclose flag to match,
+// so changing to or from a void tag (e.g. "br") takes effect immediately
+// rather than leaving the old tag's closing convention behind. tag is
+// normalized the same way NewElement normalizes it. It is a no-op,
+// logging a warning, if e is frozen.
+func (e *Element) SetTagName(tag string, autoclose bool) {
+	if e.rejectIfFrozen("SetTagName") {
+		return
+	}
+
+	e.tagname = strings.ToLower(strings.TrimSpace(tag))
+	e.autoclose = autoclose
+}
+
 // UID returns the current uid of the Element
 func (e *Element) UID() string {
 	return e.uid
@@ -171,6 +246,48 @@ func (e *Element) TextContent() string {
 
 //==============================================================================
 
+// Freeze marks e, and recursively every element already in its subtree,
+// immutable. A frozen element rejects AddChild, RemoveAttr, RemoveStyle,
+// Remove, Clean and ClassList/Attribute/Style.Apply: each becomes a
+// no-op that logs a warning instead of mutating the tree, rather than
+// panicking, since a frozen tree is meant to keep rendering safely on
+// other goroutines even if a caller forgets it's shared. This makes a
+// frozen tree safe to hand to RenderParallel or cache as a Static
+// template. Freeze has no effect on children added after it's called -
+// build the subtree first, then Freeze it. Clone still produces an
+// unfrozen copy, since a caller that wants to keep editing starts from
+// the clone, not the frozen original.
+func (e *Element) Freeze() {
+	if e.frozen {
+		return
+	}
+
+	e.frozen = true
+
+	for _, ch := range e.children {
+		if ec, ok := ch.(*Element); ok {
+			ec.Freeze()
+		}
+	}
+}
+
+// Frozen reports whether Freeze has been called on e.
+func (e *Element) Frozen() bool {
+	return e.frozen
+}
+
+// rejectIfFrozen logs and reports whether e is frozen, for a mutating
+// method to bail out of early instead of touching a frozen element's
+// state.
+func (e *Element) rejectIfFrozen(op string) bool {
+	if !e.frozen {
+		return false
+	}
+
+	log.Printf("gutrees: ignoring %s on frozen element %s", op, e)
+	return true
+}
+
 // Cleanable defines a interface for structures to self sanitize their contents.
 type Cleanable interface {
 	Clean()
@@ -178,6 +295,10 @@ type Cleanable interface {
 
 // Clean cleans out all internal markup marked as removable.
 func (e *Element) Clean() {
+	if e.rejectIfFrozen("Clean") {
+		return
+	}
+
 	for n, elm := range e.children {
 		if elm.Removed() {
 			copy(e.children[n:], e.children[n+1:])
@@ -200,8 +321,12 @@ type Removable interface {
 
 // Remove sets the markup as removable and adds a 'haikuRemoved' attribute to it
 func (e *Element) Remove() {
+	if e.rejectIfFrozen("Remove") {
+		return
+	}
+
 	if !e.Removed() {
-		e.attrs = append(e.attrs, &Attribute{"haikuRemoved", ""})
+		(&Attribute{"haikuRemoved", ""}).Apply(e)
 		e.removed = true
 	}
 }
@@ -381,8 +506,19 @@ type MarkupChildren interface {
 	Children() []Markup
 }
 
-// AddChild adds a new markup as the children of this element
+// AddChild adds a new markup as the children of this element. It panics
+// with a *CycleError if a given child is already an ancestor of e - adding
+// it would make it its own descendant and loop every tree walk forever.
+// It is a no-op, logging a warning, if e is frozen; a frozen child passed
+// in em is still attached to e, but keeps its own Parent() rather than
+// being reassigned to e, since that would mutate the frozen child's
+// state - this is what lets a frozen subtree built once be shared as a
+// child across many parents.
 func (e *Element) AddChild(em ...Markup) {
+	if e.rejectIfFrozen("AddChild") {
+		return
+	}
+
 	if e.allowChildren {
 		for _, mm := range em {
 
@@ -390,6 +526,15 @@ func (e *Element) AddChild(em ...Markup) {
 				continue
 			}
 
+			if mc, ok := mm.(*Element); ok {
+				if mc == e || isAncestor(mc, e) {
+					panic(&CycleError{Element: mc})
+				}
+				if !mc.rejectIfFrozen("AddChild (re-parenting)") {
+					mc.parent = e
+				}
+			}
+
 			if m, ok := mm.(ElementalMarkup); ok {
 				e.children = append(e.children, m)
 				//if this are free elements, then use this event manager
@@ -400,11 +545,28 @@ func (e *Element) AddChild(em ...Markup) {
 	}
 }
 
+// isAncestor reports whether candidate is already an ancestor of e,
+// walking e's parent chain.
+func isAncestor(candidate, e *Element) bool {
+	for p := e.parent; p != nil; p = p.parent {
+		if p == candidate {
+			return true
+		}
+	}
+	return false
+}
+
 // Children returns the children list for the element
 func (e *Element) Children() []Markup {
 	return e.children
 }
 
+// Parent returns the element e was last applied to as a child, or nil if
+// e has never been applied to another element.
+func (e *Element) Parent() *Element {
+	return e.parent
+}
+
 //==============================================================================
 
 // Styles return the internal style list of the element
@@ -412,11 +574,105 @@ func (e *Element) Styles() []*Style {
 	return e.styles
 }
 
+// Style returns the value of the named style property and whether it is
+// currently set on the element.
+func (e *Element) Style(prop string) (string, bool) {
+	if s, err := GetStyle(e, prop); err == nil {
+		return s.Value, true
+	}
+	return "", false
+}
+
+// RemoveStyle removes the named style property from the element, preserving
+// the order of the remaining properties. It is a no-op if the property is
+// not present.
+func (e *Element) RemoveStyle(prop string) {
+	if e.rejectIfFrozen("RemoveStyle") {
+		return
+	}
+
+	for i, s := range e.styles {
+		if s.Name == prop {
+			e.styles = append(e.styles[:i], e.styles[i+1:]...)
+			return
+		}
+	}
+}
+
 // Attributes return the internal attribute list of the element
 func (e *Element) Attributes() []*Attribute {
 	return e.attrs
 }
 
+// RemoveAttr removes the attribute matching the given name from the element.
+// It is a no-op if no such attribute is present.
+func (e *Element) RemoveAttr(name string) {
+	if e.rejectIfFrozen("RemoveAttr") {
+		return
+	}
+
+	for i, attr := range e.attrs {
+		if attr.Name == name {
+			e.attrs = append(e.attrs[:i], e.attrs[i+1:]...)
+			e.reindexAttrs()
+			return
+		}
+	}
+}
+
+// Dataset returns every "data-*" attribute on the element, keyed by its
+// camelCase name the way the DOM dataset API exposes it - "data-user-id"
+// becomes "userId". An attribute whose name doesn't start with "data-"
+// is not included.
+func (e *Element) Dataset() map[string]string {
+	data := make(map[string]string)
+
+	for _, attr := range e.attrs {
+		if !strings.HasPrefix(attr.Name, "data-") {
+			continue
+		}
+		data[datasetCamelCase(strings.TrimPrefix(attr.Name, "data-"))] = attr.Value
+	}
+
+	return data
+}
+
+// SetData sets the "data-*" attribute corresponding to key, converting
+// it from the DOM dataset API's camelCase form back to its dashed
+// attribute name - "userId" becomes "data-user-id". It's the reverse of
+// Dataset.
+func (e *Element) SetData(key, value string) {
+	(&Attribute{Name: "data-" + datasetDashCase(key), Value: value}).Apply(e)
+}
+
+// datasetCamelCase converts a dashed dataset attribute suffix ("user-id")
+// to its camelCase dataset key ("userId").
+func datasetCamelCase(dashed string) string {
+	parts := strings.Split(dashed, "-")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] == "" {
+			continue
+		}
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// datasetDashCase converts a camelCase dataset key ("userId") to its
+// dashed attribute suffix ("user-id").
+func datasetDashCase(camel string) string {
+	var out strings.Builder
+	for _, r := range camel {
+		if unicode.IsUpper(r) {
+			out.WriteByte('-')
+			out.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		out.WriteRune(r)
+	}
+	return out.String()
+}
+
 //==============================================================================
 
 // Appliable define the interface specification for applying changes to elements elements in tree
@@ -424,7 +680,9 @@ type Appliable interface {
 	Apply(Markup)
 }
 
-//Apply adds the giving element into the current elements children tree
+//Apply adds the giving element into the current elements children tree.
+//It panics with a *CycleError, via AddChild, if em is already a
+//descendant of e.
 func (e *Element) Apply(em Markup) {
 	if mm, ok := em.(MarkupChildren); ok {
 		mm.AddChild(e)
@@ -0,0 +1,42 @@
+package elems
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// conditionClause matches one downlevel-revealed conditional comment
+// clause, e.g. "IE", "IE 9", "lt IE 9" or "!lte IE 8".
+const conditionClause = `!?(?:(?:lt|lte|gt|gte)\s+)?IE(?:\s+\d+)?`
+
+// conditionRe validates a full condition expression: one or more clauses
+// joined by "&" or "|", e.g. "lt IE 9" or "IE 8 & !IE 7". It's a small
+// grammar, not a full parser, but it's enough to catch a typo (a stray
+// character, an unsupported operator) before it ends up silently inert
+// inside an HTML comment that every non-IE browser already ignores.
+var conditionRe = regexp.MustCompile(`^\s*` + conditionClause + `(?:\s*[&|]\s*` + conditionClause + `)*\s*$`)
+
+// ConditionalComment wraps markup in a downlevel-revealed conditional
+// comment, e.g. <!--[if lt IE 9]>...<![endif]-->, for the legacy IE and
+// HTML-email conditional-comment convention: every other browser treats
+// the whole thing as an ordinary comment and never renders markup, so
+// this is the one place in the tree where content that isn't there for
+// everyone still needs to render. condition is validated against
+// conditionRe first since a malformed one wouldn't fail loudly - it'd
+// just silently never match in any browser.
+func ConditionalComment(condition string, markup ...gutrees.Appliable) (*gutrees.Element, error) {
+	if !conditionRe.MatchString(condition) {
+		return nil, fmt.Errorf("elems: invalid conditional comment expression %q", condition)
+	}
+
+	e := gutrees.NewElement("conditional-comment", false)
+	(&gutrees.Attribute{Name: "data-condition", Value: condition}).Apply(e)
+
+	for _, m := range markup {
+		m.Apply(e)
+	}
+
+	return e, nil
+}
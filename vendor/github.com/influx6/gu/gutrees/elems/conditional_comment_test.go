@@ -0,0 +1,35 @@
+package elems_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestConditionalCommentWrapsStylesheetLink(t *testing.T) {
+	link := elems.Link(attrs.Rel("stylesheet"), attrs.Href("/ie8.css"))
+
+	comment, err := elems.ConditionalComment("lt IE 9", link)
+	if err != nil {
+		t.Fatalf("\t%s\t Should accept a valid condition, got err %s", failed, err)
+	}
+
+	out := string(gutrees.RenderBytes(comment))
+	if !strings.HasPrefix(out, "<!--[if lt IE 9]>") || !strings.HasSuffix(out, "<![endif]-->") {
+		t.Fatalf("\t%s\t Should wrap the markup in downlevel-revealed conditional comment syntax, got %s", failed, out)
+	}
+	if !strings.Contains(out, `href="/ie8.css"`) {
+		t.Fatalf("\t%s\t Should render the wrapped link inside the comment, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should wrap a stylesheet link in a conditional comment", success)
+}
+
+func TestConditionalCommentRejectsMalformedCondition(t *testing.T) {
+	if _, err := elems.ConditionalComment("DROP TABLE IE"); err == nil {
+		t.Fatalf("\t%s\t Should reject a condition that doesn't match the grammar", failed)
+	}
+	t.Logf("\t%s\t Should reject a malformed condition expression", success)
+}
@@ -0,0 +1,28 @@
+package elems
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// Custom returns a non-void element for tag, for authoring web
+// components (<my-widget>, <app-header>, ...) that this package's
+// generated constructors have no entry for. Per the custom-element
+// naming rule ("must contain a hyphen"), tag is rejected if it has none
+// - that's also what tells a browser's parser a tag is a custom element
+// rather than an unknown built-in one, so accepting a hyphen-less name
+// here would silently produce something that doesn't behave as a custom
+// element at all.
+func Custom(tag string, markup ...gutrees.Appliable) (*gutrees.Element, error) {
+	if !strings.Contains(tag, "-") {
+		return nil, fmt.Errorf("elems: custom element tag %q must contain a hyphen", tag)
+	}
+
+	e := gutrees.NewElement(tag, false)
+	for _, m := range markup {
+		m.Apply(e)
+	}
+	return e, nil
+}
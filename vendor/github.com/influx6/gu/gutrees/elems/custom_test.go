@@ -0,0 +1,26 @@
+package elems_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestCustomBuildsHyphenatedTag(t *testing.T) {
+	e, err := elems.Custom("my-widget")
+	if err != nil {
+		t.Fatalf("\t%s\t Should accept a hyphenated tag, got err %s", failed, err)
+	}
+	if e.TagName() != "my-widget" || e.IsVoid() {
+		t.Fatalf("\t%s\t Should build a non-void <my-widget>, got tag %q void=%v", failed, e.TagName(), e.IsVoid())
+	}
+	t.Logf("\t%s\t Should build a custom element with a hyphenated tag", success)
+}
+
+func TestCustomRejectsTagWithoutHyphen(t *testing.T) {
+	_, err := elems.Custom("mywidget")
+	if err == nil {
+		t.Fatalf("\t%s\t Should reject a tag without a hyphen", failed)
+	}
+	t.Logf("\t%s\t Should reject a non-hyphenated custom element tag", success)
+}
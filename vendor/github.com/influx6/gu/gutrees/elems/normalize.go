@@ -0,0 +1,30 @@
+// +build ignore
+
+// This file is not built: elems.NormalizedText needs
+// golang.org/x/text/unicode/norm, which isn't vendored in this tree and
+// isn't reachable to vendor for real from this environment. The code
+// below is what the real implementation looks like - vendor
+// golang.org/x/text/unicode/norm and drop the build tag above to enable
+// it, rather than hand-rolling normalization without that package.
+
+package elems
+
+import (
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// NormalizedText creates a text node like Text, but first applies NFC
+// (canonical composition) normalization to s. Text pulled from
+// different sources - copy-pasted content, different OS keyboards, some
+// CMSes - mixes NFC and NFD forms of what's visually the same character
+// (e.g. an "e" + combining acute accent vs the single precomposed "é"
+// rune), which looks identical on screen but compares and diffs as
+// different bytes. NormalizedText is opt-in rather than applied inside
+// Text itself, since normalizing content a caller didn't expect to be
+// touched would show up as a surprising, hard-to-track-down byte-level
+// diff.
+func NormalizedText(s string) *gutrees.Element {
+	return Text(norm.NFC.String(s))
+}
@@ -0,0 +1,29 @@
+package elems
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+// integrityRe matches a Subresource Integrity hash as defined by the spec:
+// one of the sha256/sha384/sha512 prefixes followed by a base64 digest.
+var integrityRe = regexp.MustCompile(`^sha(256|384|512)-[A-Za-z0-9+/]+=*$`)
+
+// ScriptSRC returns a <script> element with src, integrity and
+// crossorigin="anonymous" all set together, so CDN assets can't be wired up
+// with a mismatched or missing pair. integrity is validated to look like a
+// sha256-/sha384-/sha512- base64 hash and rejected otherwise.
+func ScriptSRC(src, integrity string) (*gutrees.Element, error) {
+	if !integrityRe.MatchString(integrity) {
+		return nil, fmt.Errorf("elems: invalid subresource integrity hash %q", integrity)
+	}
+
+	return Script(
+		attrs.Src(src),
+		attrs.Integrity(integrity),
+		attrs.Crossorigin("anonymous"),
+	), nil
+}
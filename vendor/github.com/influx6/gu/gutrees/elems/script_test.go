@@ -0,0 +1,39 @@
+package elems_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestScriptSRCValidHash(t *testing.T) {
+	script, err := elems.ScriptSRC("https://cdn.example.com/lib.js", "sha384-oqVuAfXRKap7fdgcCY5uykM6+R9GqQ8K/uxy9rx7HNQlGYl1kPzQho1wx4JwY8wC")
+	if err != nil {
+		t.Fatalf("\t%s\t Should accept a valid integrity hash, got %s", failed, err)
+	}
+
+	out, err := gutrees.SimpleMarkupWriter.Write(script)
+	if err != nil {
+		t.Fatalf("\t%s\t Should write without error, got %s", failed, err)
+	}
+
+	if !strings.Contains(out, `src="https://cdn.example.com/lib.js"`) {
+		t.Fatalf("\t%s\t Should set src, got %s", failed, out)
+	}
+	if !strings.Contains(out, `integrity="sha384-`) {
+		t.Fatalf("\t%s\t Should set integrity, got %s", failed, out)
+	}
+	if !strings.Contains(out, `crossorigin="anonymous"`) {
+		t.Fatalf("\t%s\t Should set crossorigin=\"anonymous\", got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should set src, integrity and crossorigin together", success)
+}
+
+func TestScriptSRCInvalidHash(t *testing.T) {
+	if _, err := elems.ScriptSRC("https://cdn.example.com/lib.js", "not-a-real-hash"); err == nil {
+		t.Fatalf("\t%s\t Should reject a malformed integrity hash", failed)
+	}
+	t.Logf("\t%s\t Should reject a malformed integrity hash", success)
+}
@@ -0,0 +1,20 @@
+package elems
+
+import "github.com/influx6/gu/gutrees"
+
+// Slot returns a <slot> element for use inside a Shadow root, marking
+// where light-DOM children land once the shadow tree is attached. An
+// empty name produces the default slot, which catches any child that
+// isn't explicitly assigned to a named one via attrs.Slot; a non-empty
+// name renders as <slot name="...">, matching only children assigned to
+// that name.
+func Slot(name string, markup ...gutrees.Appliable) *gutrees.Element {
+	e := gutrees.NewElement("slot", false)
+	if name != "" {
+		(&gutrees.Attribute{Name: "name", Value: name}).Apply(e)
+	}
+	for _, m := range markup {
+		m.Apply(e)
+	}
+	return e
+}
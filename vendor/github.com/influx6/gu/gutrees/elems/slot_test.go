@@ -0,0 +1,27 @@
+package elems_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestSlotRendersNameAttributeWhenNamed(t *testing.T) {
+	slot := elems.Slot("header")
+
+	name, err := gutrees.GetAttr(slot, "name")
+	if err != nil || name.Value != "header" {
+		t.Fatalf("\t%s\t Should render a name attribute for a named slot, got %q (err=%v)", failed, name, err)
+	}
+	t.Logf("\t%s\t Should render the name attribute on a named slot", success)
+}
+
+func TestSlotOmitsNameAttributeWhenDefault(t *testing.T) {
+	slot := elems.Slot("")
+
+	if _, err := gutrees.GetAttr(slot, "name"); err == nil {
+		t.Fatalf("\t%s\t Should omit the name attribute for a default slot", failed)
+	}
+	t.Logf("\t%s\t Should omit the name attribute on a default slot", success)
+}
@@ -0,0 +1,37 @@
+package elems
+
+import (
+	"strings"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// TruncateText returns a text node containing at most n runes of s,
+// appending ellipsis only when s actually had to be truncated. Truncation
+// counts by rune, not byte, so a multi-byte UTF-8 sequence is never split.
+func TruncateText(s string, n int, ellipsis string) *gutrees.Element {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return Text(s)
+	}
+
+	return Text(string(runes[:n]) + ellipsis)
+}
+
+// TrimText returns a text node containing s with leading and trailing
+// whitespace removed before the (escaped) node is created - handy for
+// template-derived strings, which often carry whitespace from
+// surrounding indentation, without a strings.TrimSpace at every call
+// site.
+func TrimText(s string) *gutrees.Element {
+	return Text(strings.TrimSpace(s))
+}
+
+// CollapseText returns a text node like TrimText, but also collapses
+// every internal run of whitespace (spaces, tabs, newlines) down to a
+// single space - the same normalization HTML rendering itself applies to
+// whitespace, useful when s was built by concatenating template
+// fragments across lines.
+func CollapseText(s string) *gutrees.Element {
+	return Text(strings.Join(strings.Fields(s), " "))
+}
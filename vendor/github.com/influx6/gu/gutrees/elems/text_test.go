@@ -0,0 +1,46 @@
+package elems_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestTruncateTextMultibyte(t *testing.T) {
+	node := elems.TruncateText("こんにちは世界", 5, "...")
+
+	if node.TextContent() != "こんにちは..." {
+		t.Fatalf("\t%s\t Should truncate by rune and append the ellipsis, got %q", failed, node.TextContent())
+	}
+	t.Logf("\t%s\t Should truncate by rune and append the ellipsis", success)
+}
+
+func TestTruncateTextUnderLimit(t *testing.T) {
+	node := elems.TruncateText("hi", 5, "...")
+
+	if node.TextContent() != "hi" {
+		t.Fatalf("\t%s\t Should leave a string under the limit untouched, got %q", failed, node.TextContent())
+	}
+	t.Logf("\t%s\t Should leave a string under the limit untouched", success)
+}
+
+func TestTrimTextRemovesSurroundingWhitespace(t *testing.T) {
+	node := elems.TrimText("  \n  hello world  \t")
+
+	if node.TextContent() != "hello world" {
+		t.Fatalf("\t%s\t Should trim leading and trailing whitespace, got %q", failed, node.TextContent())
+	}
+	t.Logf("\t%s\t Should trim surrounding whitespace", success)
+}
+
+func TestCollapseTextCollapsesInternalWhitespace(t *testing.T) {
+	node := elems.CollapseText("  hello \n  big   wide \t world  ")
+
+	if node.TextContent() != "hello big wide world" {
+		t.Fatalf("\t%s\t Should collapse internal whitespace runs to single spaces, got %q", failed, node.TextContent())
+	}
+	t.Logf("\t%s\t Should collapse internal whitespace runs", success)
+}
@@ -0,0 +1,30 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestRemoveAttr(t *testing.T) {
+	elem := gutrees.NewElement("input", true)
+	attrs.Name("email").Apply(elem)
+
+	elem.RemoveAttr("name")
+
+	if _, err := gutrees.GetAttr(elem, "name"); err == nil {
+		t.Fatalf("\t%s\t Should have removed the 'name' attribute", failed)
+	}
+	t.Logf("\t%s\t Should have removed the 'name' attribute", success)
+
+	elem.RemoveAttr("disabled")
+
+	if _, err := gutrees.GetAttr(elem, "name"); err == nil {
+		t.Fatalf("\t%s\t Should be a no-op when the attribute is absent", failed)
+	}
+	t.Logf("\t%s\t Should be a no-op when the attribute is absent", success)
+}
@@ -0,0 +1,95 @@
+package gutrees
+
+// Equal reports whether a and b are structurally identical: same tag name,
+// same autoclose flag, the same attributes (order-insignificant) and the
+// same styles and children in the same order, recursively. Text nodes are
+// equal when their TextContent matches. This tree has no separate comment
+// node or namespace concept, so neither is part of the comparison.
+//
+// Event handlers are never compared: an Event's Fx is a func value, which
+// Go can only compare against nil, not against another func for identity,
+// so Equal ignores events entirely rather than giving a misleading answer.
+// Two elements differing only in their registered events are still Equal.
+func Equal(a, b *Element) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if a.Name() != b.Name() || a.AutoClosed() != b.AutoClosed() {
+		return false
+	}
+
+	if a.Name() == "text" {
+		return a.TextContent() == b.TextContent()
+	}
+
+	if !equalAttributeSet(a.Attributes(), b.Attributes()) {
+		return false
+	}
+
+	if !equalStyleList(a.Styles(), b.Styles()) {
+		return false
+	}
+
+	aChildren, bChildren := elementChildren(a), elementChildren(b)
+	if len(aChildren) != len(bChildren) {
+		return false
+	}
+
+	for i := range aChildren {
+		if !Equal(aChildren[i], bChildren[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// elementChildren returns e's children that are *Element nodes, in order.
+func elementChildren(e *Element) []*Element {
+	var out []*Element
+	for _, c := range e.Children() {
+		if ec, ok := c.(*Element); ok {
+			out = append(out, ec)
+		}
+	}
+	return out
+}
+
+// equalAttributeSet reports whether a and b hold the same name/value
+// attribute pairs, regardless of order.
+func equalAttributeSet(a, b []*Attribute) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	am := make(map[string]string, len(a))
+	for _, at := range a {
+		am[at.Name] = at.Value
+	}
+
+	for _, bt := range b {
+		if v, ok := am[bt.Name]; !ok || v != bt.Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalStyleList reports whether a and b hold the same name/value style
+// pairs in the same order. Unlike attributes, style order can change
+// which declaration wins, so it isn't ignored here.
+func equalStyleList(a, b []*Style) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Value != b[i].Value {
+			return false
+		}
+	}
+
+	return true
+}
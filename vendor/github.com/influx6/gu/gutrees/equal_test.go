@@ -0,0 +1,54 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestEqualIdenticalTrees(t *testing.T) {
+	build := func() *gutrees.Element {
+		return elems.Div(
+			attrs.ID("a"),
+			attrs.Class("b"),
+			elems.Span(elems.Text("hello")),
+		)
+	}
+
+	if !gutrees.Equal(build(), build()) {
+		t.Fatalf("\t%s\t Should treat two independently built, structurally identical trees as equal", failed)
+	}
+	t.Logf("\t%s\t Should treat identical trees as equal", success)
+}
+
+func TestEqualDifferingAttribute(t *testing.T) {
+	a := elems.Div(attrs.ID("a"))
+	b := elems.Div(attrs.ID("b"))
+
+	if gutrees.Equal(a, b) {
+		t.Fatalf("\t%s\t Should not treat elements with a differing attribute value as equal", failed)
+	}
+	t.Logf("\t%s\t Should flag a differing attribute", success)
+}
+
+func TestEqualDifferingChildOrder(t *testing.T) {
+	a := elems.Div(elems.Span(elems.Text("one")), elems.Span(elems.Text("two")))
+	b := elems.Div(elems.Span(elems.Text("two")), elems.Span(elems.Text("one")))
+
+	if gutrees.Equal(a, b) {
+		t.Fatalf("\t%s\t Should not treat elements with children in a different order as equal", failed)
+	}
+	t.Logf("\t%s\t Should flag differing child order", success)
+}
+
+func TestEqualAttributeOrderIsIgnored(t *testing.T) {
+	a := elems.Div(attrs.ID("a"), attrs.Class("b"))
+	b := elems.Div(attrs.Class("b"), attrs.ID("a"))
+
+	if !gutrees.Equal(a, b) {
+		t.Fatalf("\t%s\t Should treat attribute order as insignificant", failed)
+	}
+	t.Logf("\t%s\t Should ignore attribute order", success)
+}
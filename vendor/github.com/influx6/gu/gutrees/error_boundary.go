@@ -0,0 +1,46 @@
+package gutrees
+
+import "fmt"
+
+// errorBoundary isolates panics raised while applying/rendering its markup,
+// substituting a fallback subtree built from the recovered error instead.
+type errorBoundary struct {
+	fallback func(error) *Element
+	markup   []Appliable
+}
+
+// ErrorBoundary wraps markup so that a panic raised while applying or
+// rendering any of it (for example inside a component's Render) is recovered
+// and replaced with the subtree returned by fallback. Only panics raised
+// synchronously within this Apply call are recovered; panics from unrelated
+// goroutines are untouched.
+func ErrorBoundary(fallback func(error) *Element, markup ...Appliable) Appliable {
+	return errorBoundary{fallback: fallback, markup: markup}
+}
+
+// Apply renders the wrapped markup into a scratch container first, so that a
+// panic partway through never leaves a partially built subtree attached to
+// the real parent, then transfers the finished children across. If a panic
+// occurs, the fallback is applied to the parent instead.
+func (b errorBoundary) Apply(m Markup) {
+	scratch := NewElement("div", false)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = fmt.Errorf("%v", r)
+			}
+
+			b.fallback(err).Apply(m)
+		}
+	}()
+
+	for _, mm := range b.markup {
+		mm.Apply(scratch)
+	}
+
+	for _, child := range scratch.Children() {
+		child.Apply(m)
+	}
+}
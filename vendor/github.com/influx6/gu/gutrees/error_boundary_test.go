@@ -0,0 +1,34 @@
+package gutrees_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+type panickyComponent struct{}
+
+func (panickyComponent) Render() *gutrees.Element {
+	panic(errors.New("boom"))
+}
+
+func TestErrorBoundaryRecoversPanic(t *testing.T) {
+	boundary := gutrees.ErrorBoundary(func(err error) *gutrees.Element {
+		return elems.Text("failed: " + err.Error())
+	}, gutrees.Component(panickyComponent{}))
+
+	root := elems.Div(boundary)
+
+	children := root.Children()
+	if len(children) != 1 {
+		t.Fatalf("\t%s\t Should have applied the fallback subtree, got %d children", failed, len(children))
+	}
+
+	fallback, ok := children[0].(*gutrees.Element)
+	if !ok || fallback.TextContent() != "failed: boom" {
+		t.Fatalf("\t%s\t Should have rendered the fallback with the recovered error", failed)
+	}
+	t.Logf("\t%s\t Should have rendered the fallback with the recovered error", success)
+}
@@ -1,6 +1,9 @@
 package gutrees
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Markup based errors relating to the type of markup
 
@@ -21,3 +24,41 @@ var ErrNotFound = errors.New("Item not found")
 
 // Errors relating to the style types
 var ErrNotStyle = errors.New("Value type is not a Style type")
+
+// ErrInvalidAttrName is returned by NewValidAttr when given a name that
+// fails ValidAttrName: one containing whitespace, a control character, or
+// one of '"\'>/=, any of which would either break out of the tag or be
+// silently mangled by a browser.
+var ErrInvalidAttrName = errors.New("gutrees: invalid attribute name")
+
+// ErrMaxDepthExceeded is returned by depth-limited render and walk
+// functions when a tree nests deeper than their configured maximum,
+// instead of letting a runaway or self-referential tree overflow the
+// stack.
+var ErrMaxDepthExceeded = errors.New("gutrees: maximum tree depth exceeded")
+
+// ErrOutputTooLarge is returned by RenderCapped when a tree's rendered
+// output exceeds the configured byte cap, instead of letting a runaway
+// or maliciously large tree exhaust memory or disk.
+var ErrOutputTooLarge = errors.New("gutrees: rendered output exceeded the configured size cap")
+
+// ErrUnsupportedPatchVersion is returned by UnmarshalPatches when the
+// envelope's version is newer than this build of the package understands,
+// rather than silently misreading a shape it was never told about.
+var ErrUnsupportedPatchVersion = errors.New("gutrees: unsupported patch envelope version")
+
+// CycleError is panicked by AddChild (and so Apply, which is built on it)
+// when adding Element would create a cycle: Element is already an
+// ancestor of the node it's being applied to, so adding it as a further
+// child would make it its own descendant. AddChild has no error return to
+// report this through - Appliable.Apply's signature is fixed across every
+// markup type in this package - so this is a panic rather than an error
+// value, same as any other broken tree invariant.
+type CycleError struct {
+	Element *Element
+}
+
+// Error describes the offending element using its debug summary.
+func (c *CycleError) Error() string {
+	return fmt.Sprintf("gutrees: applying %s would create a cycle", c.Element)
+}
@@ -0,0 +1,49 @@
+package gutrees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestDefaultEscaperEscapesTextContent(t *testing.T) {
+	tree := elems.Paragraph(elems.Text("<b>bold</b> & co"))
+
+	out := gutrees.SimpleElementWriter.Print(tree)
+	if strings.Contains(out, "<b>bold</b>") {
+		t.Fatalf("\t%s\t Should escape HTML-significant characters in text content by default, got %q", failed, out)
+	}
+	if !strings.Contains(out, "&lt;b&gt;bold&lt;/b&gt; &amp; co") {
+		t.Fatalf("\t%s\t Should render the escaped form of the text, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should escape text content using the default HTML escaper", success)
+}
+
+func TestCustomEscaperAppliesToTextButNotScriptContent(t *testing.T) {
+	// leaveAlone is a policy for content that's already encoded upstream:
+	// it passes '&' through untouched instead of double-escaping it.
+	leaveAlone := func(s string) string {
+		return strings.Replace(s, "<", "&lt;", -1)
+	}
+
+	tw := &gutrees.TextWriter{}
+	tw.SetEscaper(leaveAlone)
+	ew := gutrees.NewElementWriter(gutrees.SimpleAttrWriter, gutrees.SimpleStyleWriter, tw)
+
+	tree := elems.Div(
+		elems.Paragraph(elems.Text("a & b < c")),
+		elems.Script(elems.Text("if (a < b && c) { go(); }")),
+	)
+
+	out := ew.Print(tree)
+
+	if !strings.Contains(out, "a & b &lt; c") {
+		t.Fatalf("\t%s\t Should apply the custom escaper to ordinary text, got %q", failed, out)
+	}
+	if !strings.Contains(out, "if (a < b && c) { go(); }") {
+		t.Fatalf("\t%s\t Should leave script content untouched regardless of the configured policy, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should use a custom escaping policy for text while exempting script content", success)
+}
@@ -0,0 +1,58 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// CSRFFieldName is the form field name CSRF and InjectCSRF submit the token
+// under. Override it if your backend expects a different name.
+var CSRFFieldName = "_csrf"
+
+// CSRF returns a hidden input carrying token under CSRFFieldName.
+func CSRF(token string) *gutrees.Element {
+	return elems.Input(attrs.IType(attrs.TypeHidden), attrs.Name(CSRFFieldName), attrs.Value(token))
+}
+
+// InjectCSRF wraps markup so that every descendant <form method="post">
+// (case-insensitive) gets a CSRF hidden field appended automatically,
+// rather than requiring each form to add one by hand.
+func InjectCSRF(token string, markup ...gutrees.Appliable) gutrees.Appliable {
+	return &csrfInjector{token: token, markup: markup}
+}
+
+type csrfInjector struct {
+	token  string
+	markup []gutrees.Appliable
+}
+
+// Apply builds the wrapped markup into a scratch container, injects the
+// CSRF field into its POST-ing <form> descendants, then transfers the
+// finished children across to m.
+func (c *csrfInjector) Apply(m gutrees.Markup) {
+	scratch := gutrees.NewElement("div", false)
+
+	for _, markup := range c.markup {
+		markup.Apply(scratch)
+	}
+
+	injectCSRF(scratch, c.token)
+
+	for _, child := range scratch.Children() {
+		child.Apply(m)
+	}
+}
+
+func injectCSRF(e *gutrees.Element, token string) {
+	gutrees.Walk(e, func(el *gutrees.Element) bool {
+		if el.Name() == "form" {
+			if method, err := gutrees.GetAttr(el, "method"); err == nil && strings.EqualFold(method.Value, "post") {
+				CSRF(token).Apply(el)
+			}
+		}
+		return true
+	})
+}
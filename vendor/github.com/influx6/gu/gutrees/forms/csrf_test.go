@@ -0,0 +1,60 @@
+package forms_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+	"github.com/influx6/gu/gutrees/forms"
+)
+
+func countCSRFFields(e *gutrees.Element) int {
+	count := 0
+	for _, ch := range e.Children() {
+		child, ok := ch.(*gutrees.Element)
+		if !ok {
+			continue
+		}
+		if child.Name() == "input" {
+			if n, err := gutrees.GetAttr(child, "name"); err == nil && n.Value == forms.CSRFFieldName {
+				count++
+			}
+		}
+		count += countCSRFFields(child)
+	}
+	return count
+}
+
+func TestInjectCSRFAddsHiddenFieldOncePerPostForm(t *testing.T) {
+	root := elems.Div(
+		forms.InjectCSRF("tok123",
+			elems.Form(attrs.Method("post"), elems.Input(attrs.Name("email"))),
+			elems.Form(attrs.Method("get"), elems.Input(attrs.Name("q"))),
+		),
+	)
+
+	forms_ := root.Children()
+	postForm := forms_[0].(*gutrees.Element)
+	getForm := forms_[1].(*gutrees.Element)
+
+	if n := countCSRFFields(postForm); n != 1 {
+		t.Fatalf("\t%s\t Should add exactly one CSRF field to a POST form, got %d", failed, n)
+	}
+	t.Logf("\t%s\t Should add exactly one CSRF field to a POST form", success)
+
+	if n := countCSRFFields(getForm); n != 0 {
+		t.Fatalf("\t%s\t Should not touch a GET form, got %d fields", failed, n)
+	}
+	t.Logf("\t%s\t Should not add a CSRF field to a non-POST form", success)
+}
+
+func TestCSRFFieldValue(t *testing.T) {
+	field := forms.CSRF("tok123")
+
+	v, err := gutrees.GetAttr(field, "value")
+	if err != nil || v.Value != "tok123" {
+		t.Fatalf("\t%s\t Should carry the given token as the field value", failed)
+	}
+	t.Logf("\t%s\t Should render a hidden input carrying the CSRF token", success)
+}
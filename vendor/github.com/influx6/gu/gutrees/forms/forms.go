@@ -0,0 +1,53 @@
+// Package forms provides small conveniences for building html form markup.
+package forms
+
+import (
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// inputModeForType maps an input type to the virtual keyboard layout a
+// browser should show for it, for the types where the obvious default
+// isn't just the type name itself.
+var inputModeForType = map[attrs.InputType]string{
+	attrs.TypeNumber: "decimal",
+	attrs.TypeTel:    "tel",
+	attrs.TypeEmail:  "email",
+	attrs.TypeURL:    "url",
+	attrs.TypeSearch: "search",
+}
+
+// Input builds an <input> of type t, applying markup afterwards and
+// setting type-appropriate defaults first - currently just inputmode,
+// which keyboards use to pick a layout (e.g. a numeric pad for
+// TypeNumber) - so callers don't have to remember to repeat them at every
+// call site. Using the typed attrs.InputType constants (attrs.TypeEmail,
+// attrs.TypeNumber, ...) instead of a bare string rules out an unknown
+// input type at compile time.
+func Input(t attrs.InputType, markup ...gutrees.Appliable) *gutrees.Element {
+	input := elems.Input(attrs.IType(t))
+
+	if mode, ok := inputModeForType[t]; ok {
+		attrs.InputMode(mode).Apply(input)
+	}
+
+	for _, m := range markup {
+		m.Apply(input)
+	}
+
+	return input
+}
+
+// Field wires a label and input together using matching for/id attributes,
+// generated deterministically via gutrees.NextID so server and client agree,
+// and returns a wrapping div containing both.
+func Field(label string, input *gutrees.Element) *gutrees.Element {
+	id := gutrees.NextID("field")
+	attrs.ID(id).Apply(input)
+
+	return elems.Div(
+		elems.Label(attrs.HTMLFor(id), elems.Text(label)),
+		input,
+	)
+}
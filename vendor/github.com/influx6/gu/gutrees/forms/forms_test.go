@@ -0,0 +1,85 @@
+package forms_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+	"github.com/influx6/gu/gutrees/forms"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func idOf(t *testing.T, field *gutrees.Element) (string, string) {
+	label := field.Children()[0].(*gutrees.Element)
+	input := field.Children()[1].(*gutrees.Element)
+
+	labelFor, err := gutrees.GetAttr(label, "htmlFor")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have a 'htmlFor' attribute on the label", failed)
+	}
+
+	inputID, err := gutrees.GetAttr(input, "id")
+	if err != nil {
+		t.Fatalf("\t%s\t Should have an 'id' attribute on the input", failed)
+	}
+
+	return labelFor.Value, inputID.Value
+}
+
+func TestFieldIDsAreDistinctAndDeterministic(t *testing.T) {
+	gutrees.ResetIDs()
+
+	fieldOne := forms.Field("Email", elems.Input())
+	fieldTwo := forms.Field("Password", elems.Input())
+
+	forOne, idOne := idOf(t, fieldOne)
+	forTwo, idTwo := idOf(t, fieldTwo)
+
+	if forOne != idOne || forTwo != idTwo {
+		t.Fatalf("\t%s\t Should pair each label's 'htmlFor' with its input's 'id'", failed)
+	}
+	t.Logf("\t%s\t Should pair each label's 'htmlFor' with its input's 'id'", success)
+
+	if idOne == idTwo {
+		t.Fatalf("\t%s\t Should generate distinct ids per field, got %q twice", failed, idOne)
+	}
+	t.Logf("\t%s\t Should generate distinct ids per field", success)
+
+	gutrees.ResetIDs()
+
+	fieldOneAgain := forms.Field("Email", elems.Input())
+	forOneAgain, idOneAgain := idOf(t, fieldOneAgain)
+
+	if forOneAgain != forOne || idOneAgain != idOne {
+		t.Fatalf("\t%s\t Should render the same ids again after ResetIDs, got %q/%q want %q/%q", failed, forOneAgain, idOneAgain, forOne, idOne)
+	}
+	t.Logf("\t%s\t Should render the same ids again after ResetIDs", success)
+}
+
+func TestInputSetsTypeAndInputModeForNumber(t *testing.T) {
+	input := forms.Input(attrs.TypeNumber)
+
+	typ, err := gutrees.GetAttr(input, "type")
+	if err != nil || typ.Value != "number" {
+		t.Fatalf("\t%s\t Should set type=\"number\", got %+v, err %v", failed, typ, err)
+	}
+	t.Logf("\t%s\t Should set the input's type attribute", success)
+
+	mode, err := gutrees.GetAttr(input, "inputmode")
+	if err != nil || mode.Value != "decimal" {
+		t.Fatalf("\t%s\t Should default inputmode=\"decimal\" for a number input, got %+v, err %v", failed, mode, err)
+	}
+	t.Logf("\t%s\t Should default inputmode to a numeric keyboard for TypeNumber", success)
+}
+
+func TestInputLeavesInputModeUnsetForPlainText(t *testing.T) {
+	input := forms.Input(attrs.TypeText)
+
+	if _, err := gutrees.GetAttr(input, "inputmode"); err == nil {
+		t.Fatalf("\t%s\t Should not set an inputmode default for TypeText", failed)
+	}
+	t.Logf("\t%s\t Should leave inputmode unset when there's no sensible default", success)
+}
@@ -0,0 +1,142 @@
+package forms
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// FromStruct builds a labeled form from the exported fields of v, one
+// Field per supported field. Each field may carry a `form:"key=value,..."`
+// tag to override its name, label or type, e.g.
+// `form:"name=email,label=Email Address,type=email"`. A field tagged
+// `type=select` is rendered as a <select>, with its options given by a
+// `options=a;b;c` entry (semicolon separated, since comma already
+// separates tag entries) and the current field value marked selected.
+//
+// Supported kinds are string, the integer kinds and bool; anything else
+// (structs, slices, maps, pointers, ...) is skipped, since there's no
+// single sensible control to generate for it.
+//
+// v must be a struct or a pointer to one; a nil v, a nil pointer, or a
+// v of any other kind isn't a form to begin with, so FromStruct returns
+// an empty <div> rather than panicking on it.
+func FromStruct(v interface{}) *gutrees.Element {
+	val := reflect.Indirect(reflect.ValueOf(v))
+	if val.Kind() != reflect.Struct {
+		return elems.Div()
+	}
+	typ := val.Type()
+
+	var fields []gutrees.Appliable
+
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		opts := parseFormTag(sf.Tag.Get("form"))
+
+		name := opts["name"]
+		if name == "" {
+			name = sf.Name
+		}
+		label := opts["label"]
+		if label == "" {
+			label = sf.Name
+		}
+
+		control, ok := fieldControl(val.Field(i), name, opts)
+		if !ok {
+			continue
+		}
+
+		fields = append(fields, Field(label, control))
+	}
+
+	return elems.Div(fields...)
+}
+
+// fieldControl returns the input/select control for a single struct field,
+// or false if the field's kind (and tag) don't map to a supported control.
+func fieldControl(fv reflect.Value, name string, opts map[string]string) (*gutrees.Element, bool) {
+	if opts["type"] == "select" {
+		return selectControl(fv, name, opts), true
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		return elems.Input(attrs.Name(name), attrs.IType(attrs.TypeText), attrs.Value(fv.String())), true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return elems.Input(attrs.Name(name), attrs.IType(attrs.TypeNumber), attrs.Value(strconv.FormatInt(fv.Int(), 10))), true
+
+	case reflect.Bool:
+		checked := "false"
+		if fv.Bool() {
+			checked = "true"
+		}
+		return elems.Input(attrs.Name(name), attrs.IType(attrs.TypeCheckbox), attrs.Checked(checked)), true
+
+	default:
+		return nil, false
+	}
+}
+
+// selectControl renders a <select> from a type=select tag, marking the
+// option matching the field's current value as selected.
+func selectControl(fv reflect.Value, name string, opts map[string]string) *gutrees.Element {
+	current := fmtValue(fv)
+
+	var options []gutrees.Appliable
+	for _, val := range strings.Split(opts["options"], ";") {
+		if val == "" {
+			continue
+		}
+
+		markup := []gutrees.Appliable{attrs.Value(val), elems.Text(val)}
+		if val == current {
+			markup = append(markup, attrs.Selected("selected"))
+		}
+
+		options = append(options, elems.Option(markup...))
+	}
+
+	return elems.Select(append([]gutrees.Appliable{attrs.Name(name)}, options...)...)
+}
+
+// fmtValue renders a reflect.Value as the plain string an HTML attribute
+// needs, for the kinds FromStruct supports.
+func fmtValue(fv reflect.Value) string {
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(fv.Int(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(fv.Bool())
+	default:
+		return fv.String()
+	}
+}
+
+// parseFormTag parses a `key=value,key=value` form tag into a lookup map.
+func parseFormTag(tag string) map[string]string {
+	opts := make(map[string]string)
+	if tag == "" {
+		return opts
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		opts[kv[0]] = kv[1]
+	}
+
+	return opts
+}
@@ -0,0 +1,93 @@
+package forms_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/forms"
+)
+
+type signupForm struct {
+	Name    string `form:"label=Full Name"`
+	Age     int
+	Active  bool
+	Country string `form:"type=select,options=US;CA;UK"`
+}
+
+func findInput(t *testing.T, root *gutrees.Element, name string) *gutrees.Element {
+	for _, ch := range root.Children() {
+		field := ch.(*gutrees.Element)
+		control := field.Children()[1].(*gutrees.Element)
+		if n, err := gutrees.GetAttr(control, "name"); err == nil && n.Value == name {
+			return control
+		}
+	}
+	t.Fatalf("\t%s\t Should find a control named %q", failed, name)
+	return nil
+}
+
+func TestFromStructStringField(t *testing.T) {
+	root := forms.FromStruct(signupForm{Name: "Ada", Country: "CA"})
+
+	input := findInput(t, root, "Name")
+	if input.Name() != "input" {
+		t.Fatalf("\t%s\t Should render a string field as an input, got %q", failed, input.Name())
+	}
+	v, err := gutrees.GetAttr(input, "value")
+	if err != nil || v.Value != "Ada" {
+		t.Fatalf("\t%s\t Should carry the struct's current value", failed)
+	}
+	t.Logf("\t%s\t Should render a string field as a text input carrying its value", success)
+}
+
+func TestFromStructIntField(t *testing.T) {
+	root := forms.FromStruct(signupForm{Age: 30})
+
+	input := findInput(t, root, "Age")
+	typ, err := gutrees.GetAttr(input, "type")
+	if err != nil || typ.Value != "number" {
+		t.Fatalf("\t%s\t Should render an int field as a number input, got %+v", failed, typ)
+	}
+	t.Logf("\t%s\t Should render an int field as a number input", success)
+}
+
+func TestFromStructBoolField(t *testing.T) {
+	root := forms.FromStruct(signupForm{Active: true})
+
+	input := findInput(t, root, "Active")
+	typ, err := gutrees.GetAttr(input, "type")
+	if err != nil || typ.Value != "checkbox" {
+		t.Fatalf("\t%s\t Should render a bool field as a checkbox, got %+v", failed, typ)
+	}
+	t.Logf("\t%s\t Should render a bool field as a checkbox", success)
+}
+
+func TestFromStructTagDrivenSelect(t *testing.T) {
+	root := forms.FromStruct(signupForm{Country: "CA"})
+
+	sel := findInput(t, root, "Country")
+	if sel.Name() != "select" {
+		t.Fatalf("\t%s\t Should render a type=select field as a select, got %q", failed, sel.Name())
+	}
+
+	options := sel.Children()
+	if len(options) != 3 {
+		t.Fatalf("\t%s\t Should render one option per entry in options=, got %d", failed, len(options))
+	}
+
+	ca := options[1].(*gutrees.Element)
+	if _, err := gutrees.GetAttr(ca, "selected"); err != nil {
+		t.Fatalf("\t%s\t Should mark the option matching the current value as selected", failed)
+	}
+	t.Logf("\t%s\t Should render a tag-driven select with the current value selected", success)
+}
+
+func TestFromStructNonStructReturnsEmptyDiv(t *testing.T) {
+	for _, v := range []interface{}{nil, 42, "not a struct", []int{1, 2}, (*signupForm)(nil)} {
+		root := forms.FromStruct(v)
+		if root.Name() != "div" || len(root.Children()) != 0 {
+			t.Fatalf("\t%s\t Should return an empty div for non-struct value %#v, got %q with %d children", failed, v, root.Name(), len(root.Children()))
+		}
+	}
+	t.Logf("\t%s\t Should return an empty div instead of panicking on a non-struct value", success)
+}
@@ -0,0 +1,60 @@
+package forms
+
+import (
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// Option describes a single <option> by its submitted value and its
+// displayed label.
+type Option struct {
+	Value string
+	Label string
+}
+
+// OptionGroup nests a set of Options under a labeled <optgroup>.
+type OptionGroup struct {
+	Label   string
+	Options []Option
+}
+
+// Selectable is implemented by anything Select can place directly inside a
+// <select>: a plain Option or a labeled OptionGroup.
+type Selectable interface {
+	applyTo(sel *gutrees.Element, selected string)
+}
+
+func (o Option) applyTo(sel *gutrees.Element, selected string) {
+	elems.Option(optionMarkup(o, selected)...).Apply(sel)
+}
+
+func (g OptionGroup) applyTo(sel *gutrees.Element, selected string) {
+	group := elems.OptionsGroup(attrs.Label(g.Label))
+	for _, o := range g.Options {
+		elems.Option(optionMarkup(o, selected)...).Apply(group)
+	}
+	group.Apply(sel)
+}
+
+// optionMarkup returns the attrs/children for a single <option>, marking it
+// selected when its value matches the select's current value.
+func optionMarkup(o Option, selected string) []gutrees.Appliable {
+	markup := []gutrees.Appliable{attrs.Value(o.Value), elems.Text(o.Label)}
+	if o.Value == selected {
+		markup = append(markup, attrs.Selected("selected"))
+	}
+	return markup
+}
+
+// Select builds a <select name="name"> from items (Option and OptionGroup
+// mixed freely), marking whichever Option's Value equals selected.
+func Select(name string, items []Selectable, selected string) *gutrees.Element {
+	sel := elems.Select(attrs.Name(name))
+
+	for _, item := range items {
+		item.applyTo(sel, selected)
+	}
+
+	return sel
+}
@@ -0,0 +1,66 @@
+package forms_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/forms"
+)
+
+func TestSelectGroupedWithPreselectedValue(t *testing.T) {
+	sel := forms.Select("country", []forms.Selectable{
+		forms.Option{Value: "US", Label: "United States"},
+		forms.OptionGroup{
+			Label: "Europe",
+			Options: []forms.Option{
+				{Value: "FR", Label: "France"},
+				{Value: "DE", Label: "Germany"},
+			},
+		},
+	}, "DE")
+
+	if sel.Name() != "select" {
+		t.Fatalf("\t%s\t Should render a <select>, got %q", failed, sel.Name())
+	}
+
+	name, err := gutrees.GetAttr(sel, "name")
+	if err != nil || name.Value != "country" {
+		t.Fatalf("\t%s\t Should set name on the select", failed)
+	}
+
+	children := sel.Children()
+	if len(children) != 2 {
+		t.Fatalf("\t%s\t Should have one plain option and one group, got %d", failed, len(children))
+	}
+
+	us := children[0].(*gutrees.Element)
+	if us.Name() != "option" {
+		t.Fatalf("\t%s\t Should render a top-level Option as <option>, got %q", failed, us.Name())
+	}
+	if _, err := gutrees.GetAttr(us, "selected"); err == nil {
+		t.Fatalf("\t%s\t Should not mark a non-matching option as selected", failed)
+	}
+	t.Logf("\t%s\t Should render a top-level Option as <option>", success)
+
+	group := children[1].(*gutrees.Element)
+	if group.Name() != "optgroup" {
+		t.Fatalf("\t%s\t Should render an OptionGroup as <optgroup>, got %q", failed, group.Name())
+	}
+
+	label, err := gutrees.GetAttr(group, "label")
+	if err != nil || label.Value != "Europe" {
+		t.Fatalf("\t%s\t Should set label on the optgroup", failed)
+	}
+
+	groupOptions := group.Children()
+	if len(groupOptions) != 2 {
+		t.Fatalf("\t%s\t Should nest both options under the group, got %d", failed, len(groupOptions))
+	}
+	t.Logf("\t%s\t Should nest OptionGroup options under an <optgroup>", success)
+
+	de := groupOptions[1].(*gutrees.Element)
+	if _, err := gutrees.GetAttr(de, "selected"); err != nil {
+		t.Fatalf("\t%s\t Should mark the option matching the selected value", failed)
+	}
+	t.Logf("\t%s\t Should mark the matching option as selected by value", success)
+}
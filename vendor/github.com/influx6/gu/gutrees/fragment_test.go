@@ -0,0 +1,28 @@
+package gutrees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestFragmentRendersChildrenOnly(t *testing.T) {
+	frag := gutrees.NewFragment(elems.Paragraph(elems.Text("one")), elems.Paragraph(elems.Text("two")))
+
+	out, err := gutrees.SimpleMarkupWriter.Write(frag)
+	if err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	if strings.Contains(out, "<fragment") {
+		t.Fatalf("\t%s\t Should not emit a wrapping tag, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should not emit a wrapping tag", success)
+
+	if !strings.Contains(out, "one") || !strings.Contains(out, "two") {
+		t.Fatalf("\t%s\t Should render both children, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should render both children", success)
+}
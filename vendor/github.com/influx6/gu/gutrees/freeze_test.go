@@ -0,0 +1,92 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestFreezeRejectsAddChild(t *testing.T) {
+	tree := elems.Div(elems.Paragraph(elems.Text("one")))
+	tree.Freeze()
+
+	before := len(tree.Children())
+	elems.Span().Apply(tree)
+
+	if len(tree.Children()) != before {
+		t.Fatalf("\t%s\t Should reject AddChild on a frozen element", failed)
+	}
+	t.Logf("\t%s\t Should reject AddChild on a frozen element", success)
+}
+
+func TestFreezeRejectsAttributeApply(t *testing.T) {
+	e := elems.Div()
+	e.Freeze()
+
+	attrs.Class("new-class").Apply(e)
+
+	if _, err := gutrees.GetAttr(e, "class"); err == nil {
+		t.Fatalf("\t%s\t Should reject a new attribute applied to a frozen element", failed)
+	}
+	t.Logf("\t%s\t Should reject Attribute.Apply on a frozen element", success)
+}
+
+func TestFreezeRejectsRemoveAttr(t *testing.T) {
+	e := elems.Div(attrs.ID("keep"))
+	e.Freeze()
+
+	e.RemoveAttr("id")
+
+	if _, err := gutrees.GetAttr(e, "id"); err != nil {
+		t.Fatalf("\t%s\t Should keep the attribute in place on a frozen element", failed)
+	}
+	t.Logf("\t%s\t Should reject RemoveAttr on a frozen element", success)
+}
+
+func TestFreezeCascadesToExistingChildren(t *testing.T) {
+	child := elems.Paragraph(elems.Text("hi"))
+	tree := elems.Div(child)
+	tree.Freeze()
+
+	if !child.Frozen() {
+		t.Fatalf("\t%s\t Should freeze children that existed before Freeze was called", failed)
+	}
+	t.Logf("\t%s\t Should recursively freeze existing children", success)
+}
+
+func TestFrozenElementCanBeAttachedAsChild(t *testing.T) {
+	frozen := elems.Div(elems.Text("shared"))
+	frozen.Freeze()
+
+	parent := elems.Span(frozen)
+
+	if len(parent.Children()) != 1 {
+		t.Fatalf("\t%s\t Should attach a frozen element as a child, got %d children", failed, len(parent.Children()))
+	}
+	if frozen.Parent() != nil {
+		t.Fatalf("\t%s\t Should leave a frozen child's own Parent() untouched", failed)
+	}
+	t.Logf("\t%s\t Should attach a frozen element as a child without re-parenting it", success)
+}
+
+func TestCloneOfFrozenElementIsMutable(t *testing.T) {
+	tree := elems.Div(attrs.ID("frozen-one"))
+	tree.Freeze()
+
+	clone, ok := tree.Clone().(*gutrees.Element)
+	if !ok {
+		t.Fatalf("\t%s\t Should clone into a *gutrees.Element", failed)
+	}
+
+	if clone.Frozen() {
+		t.Fatalf("\t%s\t Should produce an unfrozen clone", failed)
+	}
+
+	attrs.Class("added").Apply(clone)
+	if _, err := gutrees.GetAttr(clone, "class"); err != nil {
+		t.Fatalf("\t%s\t Should allow mutating the clone, got err %v", failed, err)
+	}
+	t.Logf("\t%s\t Should produce a mutable clone of a frozen element", success)
+}
@@ -0,0 +1,165 @@
+// Package gutreestest provides test helpers for comparing rendered HTML
+// structurally instead of byte-for-byte, so tests don't break on cosmetic
+// differences like attribute ordering or incidental whitespace.
+package gutreestest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// AssertEqualHTML fails t with a readable diff unless expected and actual
+// parse to the same structure: same tags and attributes (order
+// insignificant), same children in the same order, and the same text once
+// insignificant whitespace is normalized away. Content inside <pre> and
+// <textarea> is compared verbatim, since whitespace is significant there.
+func AssertEqualHTML(t *testing.T, expected, actual string) {
+	t.Helper()
+
+	same, expTree, actTree, err := compare(expected, actual)
+	if err != nil {
+		t.Fatalf("AssertEqualHTML: %v", err)
+	}
+
+	if !same {
+		t.Fatalf("AssertEqualHTML: HTML mismatch\n--- expected ---\n%s--- actual ---\n%s",
+			dump(expTree, 0, false), dump(actTree, 0, false))
+	}
+}
+
+// Equal reports whether expected and actual parse to the same structure,
+// under the same rules as AssertEqualHTML, for callers that want the bare
+// boolean instead of a t.Fatalf side effect.
+func Equal(expected, actual string) (bool, error) {
+	same, _, _, err := compare(expected, actual)
+	return same, err
+}
+
+// compare parses expected and actual and reports whether they're
+// structurally equal, along with the parsed trees for diagnostics.
+func compare(expected, actual string) (same bool, expTree, actTree *gutrees.Element, err error) {
+	expTree, err = gutrees.Parse(expected)
+	if err != nil {
+		return false, nil, nil, fmt.Errorf("failed to parse expected HTML: %v", err)
+	}
+
+	actTree, err = gutrees.Parse(actual)
+	if err != nil {
+		return false, nil, nil, fmt.Errorf("failed to parse actual HTML: %v", err)
+	}
+
+	return equal(expTree, actTree, false), expTree, actTree, nil
+}
+
+// equal reports whether a and b are structurally the same element,
+// comparing their subtrees pairwise in document order. preformatted marks
+// that an ancestor was <pre> or <textarea>, so text nodes compare
+// verbatim rather than whitespace-normalized.
+func equal(a, b *gutrees.Element, preformatted bool) bool {
+	if a.Name() != b.Name() || a.AutoClosed() != b.AutoClosed() {
+		return false
+	}
+
+	if a.Name() == "text" {
+		return normalizeText(a.TextContent(), preformatted) == normalizeText(b.TextContent(), preformatted)
+	}
+
+	if !equalAttrs(a.Attributes(), b.Attributes()) {
+		return false
+	}
+
+	preformatted = preformatted || isPreformatted(a.Name())
+
+	aChildren, bChildren := elementChildren(a), elementChildren(b)
+	if len(aChildren) != len(bChildren) {
+		return false
+	}
+
+	for i := range aChildren {
+		if !equal(aChildren[i], bChildren[i], preformatted) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isPreformatted(tag string) bool {
+	return tag == "pre" || tag == "textarea"
+}
+
+// elementChildren returns e's children that are themselves *Element nodes
+// (text nodes included), skipping any other Markup implementation.
+func elementChildren(e *gutrees.Element) []*gutrees.Element {
+	var out []*gutrees.Element
+	for _, c := range e.Children() {
+		if ec, ok := c.(*gutrees.Element); ok {
+			out = append(out, ec)
+		}
+	}
+	return out
+}
+
+func equalAttrs(a, b []*gutrees.Attribute) bool {
+	am, bm := attrMap(a), attrMap(b)
+	if len(am) != len(bm) {
+		return false
+	}
+	for name, val := range am {
+		if bm[name] != val {
+			return false
+		}
+	}
+	return true
+}
+
+func attrMap(attrs []*gutrees.Attribute) map[string]string {
+	m := make(map[string]string, len(attrs))
+	for _, a := range attrs {
+		m[a.Name] = a.Value
+	}
+	return m
+}
+
+// normalizeText collapses runs of whitespace to a single space and trims
+// the ends, unless preformatted, in which case it's returned unchanged.
+func normalizeText(s string, preformatted bool) string {
+	if preformatted {
+		return s
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// dump renders e as an indented, attribute-sorted outline for diagnostics.
+func dump(e *gutrees.Element, depth int, preformatted bool) string {
+	indent := strings.Repeat("  ", depth)
+
+	if e.Name() == "text" {
+		return fmt.Sprintf("%s#text %q\n", indent, normalizeText(e.TextContent(), preformatted))
+	}
+
+	am := attrMap(e.Attributes())
+	names := make([]string, 0, len(am))
+	for name := range am {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s<%s", indent, e.Name())
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%q", name, am[name])
+	}
+	b.WriteString(">\n")
+
+	preformatted = preformatted || isPreformatted(e.Name())
+	for _, ch := range elementChildren(e) {
+		b.WriteString(dump(ch, depth+1, preformatted))
+	}
+
+	return b.String()
+}
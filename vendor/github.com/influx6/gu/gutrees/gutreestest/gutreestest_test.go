@@ -0,0 +1,43 @@
+package gutreestest_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/gutreestest"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestAssertEqualHTMLIgnoresAttributeOrderAndWhitespace(t *testing.T) {
+	expected := `<div id="a" class="b">  Hello   world  </div>`
+	actual := "<div class=\"b\" id=\"a\">\n  Hello\n  world\n</div>"
+
+	gutreestest.AssertEqualHTML(t, expected, actual)
+	t.Logf("\t%s\t Should treat attribute order and insignificant whitespace as cosmetic", success)
+}
+
+func TestEqualReportsMismatchOnDifferingText(t *testing.T) {
+	same, err := gutreestest.Equal(`<div>a</div>`, `<div>b</div>`)
+	if err != nil {
+		t.Fatalf("\t%s\t Should parse both sides without error, got %v", failed, err)
+	}
+	if same {
+		t.Fatalf("\t%s\t Should report a mismatch when the rendered text actually differs", failed)
+	}
+	t.Logf("\t%s\t Should report a genuine structural mismatch", success)
+}
+
+func TestEqualTreatsPreWhitespaceAsSignificant(t *testing.T) {
+	expected := "<pre>line one\n  line two</pre>"
+	actual := "<pre>line one\nline two</pre>"
+
+	same, err := gutreestest.Equal(expected, actual)
+	if err != nil {
+		t.Fatalf("\t%s\t Should parse both sides without error, got %v", failed, err)
+	}
+	if same {
+		t.Fatalf("\t%s\t Should treat a changed leading indent inside <pre> as a real difference", failed)
+	}
+	t.Logf("\t%s\t Should treat whitespace inside <pre> as significant", success)
+}
@@ -0,0 +1,55 @@
+package gutrees
+
+import "strconv"
+
+// ShiftHeadings walks root and its descendants, incrementing every
+// heading element's (h1-h6) level by by, using SetTagName so the
+// autoclose flag - unaffected either way for headings - stays correct.
+// The result is clamped to h6 rather than wrapping or going past it, so
+// embedding syndicated content that brings its own h1 underneath a
+// page's existing h1 can't produce an invalid, deeper-than-h6 outline.
+// by may be negative to shift levels up instead. Non-heading elements
+// are left untouched.
+func ShiftHeadings(root *Element, by int) {
+	Walk(root, func(e *Element) bool {
+		level, ok := headingLevel(e.Name())
+		if !ok {
+			return true
+		}
+
+		shifted := level + by
+		if shifted > 6 {
+			shifted = 6
+		}
+		if shifted < 1 {
+			shifted = 1
+		}
+
+		e.SetTagName("h"+strconv.Itoa(shifted), e.AutoClosed())
+		return true
+	})
+}
+
+// HeadingLevel reports the numeric level of a heading tag name ("h1"
+// through "h6") and whether name is one at all. It's the exported form
+// of the check ShiftHeadings and SlugifyHeadings use internally, for
+// packages like components that need to walk a document's heading
+// structure themselves.
+func HeadingLevel(name string) (int, bool) {
+	return headingLevel(name)
+}
+
+// headingLevel reports the numeric level of a heading tag name ("h1"
+// through "h6") and whether name is one at all.
+func headingLevel(name string) (int, bool) {
+	if len(name) != 2 || name[0] != 'h' {
+		return 0, false
+	}
+
+	level, err := strconv.Atoi(name[1:])
+	if err != nil || level < 1 || level > 6 {
+		return 0, false
+	}
+
+	return level, true
+}
@@ -0,0 +1,48 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestShiftHeadingsIncrementsLevelsAndClampsAtH6(t *testing.T) {
+	tree := elems.Div(
+		elems.Header1(elems.Text("title")),
+		elems.Header2(elems.Text("subtitle")),
+		elems.Header6(elems.Text("deepest")),
+	)
+
+	gutrees.ShiftHeadings(tree, 1)
+
+	children := tree.Children()
+	h1, ok1 := children[0].(*gutrees.Element)
+	h2, ok2 := children[1].(*gutrees.Element)
+	h6, ok3 := children[2].(*gutrees.Element)
+	if !ok1 || !ok2 || !ok3 {
+		t.Fatalf("\t%s\t Should have three *gutrees.Element children", failed)
+	}
+
+	if h1.TagName() != "h2" {
+		t.Fatalf("\t%s\t Should shift h1 to h2, got %q", failed, h1.TagName())
+	}
+	if h2.TagName() != "h3" {
+		t.Fatalf("\t%s\t Should shift h2 to h3, got %q", failed, h2.TagName())
+	}
+	if h6.TagName() != "h6" {
+		t.Fatalf("\t%s\t Should clamp h6 at h6, got %q", failed, h6.TagName())
+	}
+	t.Logf("\t%s\t Should shift heading levels and clamp at h6", success)
+}
+
+func TestShiftHeadingsLeavesNonHeadingsAlone(t *testing.T) {
+	tree := elems.Paragraph(elems.Text("body"))
+
+	gutrees.ShiftHeadings(tree, 2)
+
+	if tree.TagName() != "p" {
+		t.Fatalf("\t%s\t Should leave a non-heading tag unchanged, got %q", failed, tree.TagName())
+	}
+	t.Logf("\t%s\t Should leave non-heading elements untouched", success)
+}
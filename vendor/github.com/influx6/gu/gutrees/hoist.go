@@ -0,0 +1,58 @@
+package gutrees
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HoistInlineStyles walks root, replacing every inline style="" with a
+// generated class and collecting the equivalent rules into a <style>
+// block, for CSP policies that disallow inline styles outright. Two
+// elements with byte-identical style content share one generated class
+// rather than getting a rule each, so a component applied many times
+// doesn't balloon the stylesheet. It returns a fragment of the <style>
+// block followed by root, so the result can be rendered or appended to a
+// tree directly; root itself is mutated in place.
+func HoistInlineStyles(root *Element) *Element {
+	classForBody := make(map[string]string)
+	var bodies []string
+	var counter int
+
+	Walk(root, func(e *Element) bool {
+		styles := e.Styles()
+		if len(styles) == 0 {
+			return true
+		}
+
+		body := strings.TrimSpace(SimpleStyleWriter.Print(styles))
+
+		class, ok := classForBody[body]
+		if !ok {
+			counter++
+			class = fmt.Sprintf("gu-hoist-%d", counter)
+			classForBody[body] = class
+			bodies = append(bodies, body)
+		}
+
+		for _, s := range append([]*Style(nil), styles...) {
+			e.RemoveStyle(s.Name)
+		}
+		(&ClassList{class}).Apply(e)
+
+		return true
+	})
+
+	if len(bodies) == 0 {
+		return root
+	}
+
+	var css strings.Builder
+	for _, body := range bodies {
+		fmt.Fprintf(&css, ".%s { %s }\n", classForBody[body], body)
+	}
+
+	style := NewElement("style", false)
+	NewText(css.String()).Apply(style)
+
+	return NewFragment(style, root)
+}
@@ -0,0 +1,55 @@
+package gutrees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestHoistInlineStylesRemovesStyleAttrAndEmitsRule(t *testing.T) {
+	div := gutrees.NewElement("div", false)
+	(&gutrees.Style{Name: "color", Value: "red"}).Apply(div)
+
+	result := gutrees.HoistInlineStyles(div)
+
+	if len(div.Styles()) != 0 {
+		t.Fatalf("\t%s\t Should remove the inline style from the element, got %+v", failed, div.Styles())
+	}
+	t.Logf("\t%s\t Should remove the inline style attribute", success)
+
+	class, err := gutrees.GetAttr(div, "class")
+	if err != nil || class.Value == "" {
+		t.Fatalf("\t%s\t Should assign a generated class, got %+v, err %v", failed, class, err)
+	}
+
+	out := string(gutrees.RenderBytes(result))
+	if !strings.Contains(out, "<style") {
+		t.Fatalf("\t%s\t Should include a <style> block, got %s", failed, out)
+	}
+	if !strings.Contains(out, "."+class.Value) || !strings.Contains(out, "color:red") {
+		t.Fatalf("\t%s\t Should emit a rule matching the generated class, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should emit a matching rule in the hoisted style block", success)
+}
+
+func TestHoistInlineStylesSharesClassForIdenticalStyles(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+
+	a := gutrees.NewElement("span", false)
+	(&gutrees.Style{Name: "color", Value: "blue"}).Apply(a)
+	a.Apply(root)
+
+	b := gutrees.NewElement("span", false)
+	(&gutrees.Style{Name: "color", Value: "blue"}).Apply(b)
+	b.Apply(root)
+
+	gutrees.HoistInlineStyles(root)
+
+	classA, _ := gutrees.GetAttr(a, "class")
+	classB, _ := gutrees.GetAttr(b, "class")
+	if classA.Value != classB.Value {
+		t.Fatalf("\t%s\t Should share one class for identical style content, got %q and %q", failed, classA.Value, classB.Value)
+	}
+	t.Logf("\t%s\t Should share a generated class between elements with identical inline styles", success)
+}
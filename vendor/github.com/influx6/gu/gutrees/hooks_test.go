@@ -0,0 +1,58 @@
+package gutrees_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestRenderHooksRecordEnterAndExitCounts(t *testing.T) {
+	tree := elems.Div(
+		elems.Paragraph(elems.Text("one")),
+		elems.Paragraph(elems.Text("two")),
+	)
+
+	var entered, exited int
+
+	ew := gutrees.NewElementWriter(gutrees.SimpleAttrWriter, gutrees.SimpleStyleWriter, gutrees.SimpleTextWriter)
+	ew.SetHooks(gutrees.RenderHooks{
+		OnEnter: func(e *gutrees.Element) { entered++ },
+		OnExit:  func(e *gutrees.Element, took time.Duration) { exited++ },
+	})
+
+	var buf bytes.Buffer
+	if err := gutrees.RenderIntoWith(&buf, ew, tree); err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	// div + 2 paragraphs + 2 text nodes = 5 elements visited.
+	if entered != 5 || exited != 5 {
+		t.Fatalf("\t%s\t Should call OnEnter/OnExit once per element, got entered=%d exited=%d", failed, entered, exited)
+	}
+	t.Logf("\t%s\t Should record matching enter/exit counts for every element in the tree", success)
+}
+
+func TestRenderHooksDoNotAlterOutput(t *testing.T) {
+	tree := elems.Paragraph(elems.Text("hi"))
+
+	plain := gutrees.RenderBytes(tree)
+
+	ew := gutrees.NewElementWriter(gutrees.SimpleAttrWriter, gutrees.SimpleStyleWriter, gutrees.SimpleTextWriter)
+	ew.SetHooks(gutrees.RenderHooks{
+		OnEnter: func(e *gutrees.Element) {},
+		OnExit:  func(e *gutrees.Element, took time.Duration) {},
+	})
+
+	var buf bytes.Buffer
+	if err := gutrees.RenderIntoWith(&buf, ew, tree); err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	if buf.String() != string(plain) {
+		t.Fatalf("\t%s\t Should render identical output with hooks installed, got %q want %q", failed, buf.String(), string(plain))
+	}
+	t.Logf("\t%s\t Should leave rendered output unchanged when hooks are installed", success)
+}
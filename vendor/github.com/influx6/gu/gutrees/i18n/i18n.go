@@ -0,0 +1,219 @@
+// Package i18n provides small locale-aware helpers for formatting numbers
+// and dates as gutrees text nodes, keeping locale formatting out of
+// application code.
+//
+// Locales are identified by a plain BCP-47 tag string rather than
+// golang.org/x/text/language.Tag: that package isn't part of this
+// project's vendored dependencies, and vendoring the whole text/language
+// machinery just for these few helpers isn't worth the footprint. Only the
+// primary language subtag is consulted; a locale this package doesn't
+// recognize falls back to "en" formatting.
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// numberSeparators holds the [grouping, decimal] separator pair
+// conventional for a locale's primary language subtag.
+var numberSeparators = map[string][2]string{
+	"en": {",", "."},
+	"de": {".", ","},
+	"fr": {" ", ","},
+}
+
+// dateLayouts holds the conventional Go time layout for a locale's primary
+// language subtag.
+var dateLayouts = map[string]string{
+	"en": "1/2/2006",
+	"de": "2.1.2006",
+	"fr": "2/1/2006",
+}
+
+// primaryTag returns the lowercased primary language subtag of a BCP-47
+// locale string, e.g. "en" from "en-US".
+func primaryTag(locale string) string {
+	if i := strings.IndexByte(locale, '-'); i >= 0 {
+		locale = locale[:i]
+	}
+	return strings.ToLower(locale)
+}
+
+// Number returns a text node containing v formatted with the grouping and
+// decimal separators conventional for locale.
+func Number(v float64, locale string) *gutrees.Element {
+	return elems.Text(formatNumber(v, locale))
+}
+
+func formatNumber(v float64, locale string) string {
+	sep, ok := numberSeparators[primaryTag(locale)]
+	if !ok {
+		sep = numberSeparators["en"]
+	}
+
+	str := strconv.FormatFloat(v, 'f', -1, 64)
+
+	intPart, fracPart := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		intPart, fracPart = str[:i], str[i+1:]
+	}
+
+	negative := strings.HasPrefix(intPart, "-")
+	intPart = strings.TrimPrefix(intPart, "-")
+
+	grouped := groupDigits(intPart, sep[0])
+	if negative {
+		grouped = "-" + grouped
+	}
+
+	if fracPart == "" {
+		return grouped
+	}
+	return grouped + sep[1] + fracPart
+}
+
+// groupDigits inserts sep every three digits from the right of digits.
+func groupDigits(digits, sep string) string {
+	n := len(digits)
+	if n <= 3 {
+		return digits
+	}
+
+	var parts []string
+	for n > 3 {
+		parts = append([]string{digits[n-3:]}, parts...)
+		digits = digits[:n-3]
+		n = len(digits)
+	}
+	parts = append([]string{digits}, parts...)
+
+	return strings.Join(parts, sep)
+}
+
+// Date returns a <time> element whose datetime attribute carries t in
+// RFC3339, and whose text content is t formatted conventionally for
+// locale.
+func Date(t time.Time, locale string) *gutrees.Element {
+	layout, ok := dateLayouts[primaryTag(locale)]
+	if !ok {
+		layout = dateLayouts["en"]
+	}
+
+	return elems.Time(
+		attrs.DateTime(t.Format(time.RFC3339)),
+		elems.Text(t.Format(layout)),
+	)
+}
+
+// PluralForms holds a format string per CLDR plural category, each
+// containing exactly one %d verb for the count. Other is required and
+// used whenever a locale doesn't distinguish, or doesn't use, a given
+// category; One, Few and Many may be left blank to fall back to Other.
+type PluralForms struct {
+	One   string
+	Few   string
+	Many  string
+	Other string
+}
+
+// Plural returns a text node with the PluralForms entry matching count's
+// CLDR plural category for locale, substituted with count. Category rules
+// are implemented for English (one/other) and for Polish and Russian,
+// which distinguish a richer one/few/many/other set; any other locale
+// falls back to the English rule.
+func Plural(count int, forms PluralForms, locale string) *gutrees.Element {
+	form := selectForm(forms, pluralCategory(count, primaryTag(locale)))
+	return elems.Text(fmt.Sprintf(form, count))
+}
+
+// selectForm returns forms' entry for category, falling back to
+// forms.Other if that entry is blank.
+func selectForm(forms PluralForms, category string) string {
+	switch category {
+	case "one":
+		if forms.One != "" {
+			return forms.One
+		}
+	case "few":
+		if forms.Few != "" {
+			return forms.Few
+		}
+	case "many":
+		if forms.Many != "" {
+			return forms.Many
+		}
+	}
+	return forms.Other
+}
+
+// pluralCategory returns the CLDR plural category ("one", "few", "many" or
+// "other") count falls into for lang, the primary language subtag of a
+// locale.
+func pluralCategory(count int, lang string) string {
+	switch lang {
+	case "pl":
+		return polishPluralCategory(count)
+	case "ru":
+		return russianPluralCategory(count)
+	default:
+		return englishPluralCategory(count)
+	}
+}
+
+// englishPluralCategory implements CLDR's English rule: one is exactly 1,
+// everything else (including 0) is other.
+func englishPluralCategory(n int) string {
+	if n == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+// polishPluralCategory implements CLDR's Polish rule for integers.
+func polishPluralCategory(n int) string {
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	mod10, mod100 := abs%10, abs%100
+	inTeens := mod100 >= 12 && mod100 <= 14
+
+	switch {
+	case abs == 1:
+		return "one"
+	case mod10 >= 2 && mod10 <= 4 && !inTeens:
+		return "few"
+	case (abs != 1 && mod10 <= 1 && !inTeens) || (mod10 >= 5 && mod10 <= 9) || inTeens:
+		return "many"
+	default:
+		return "other"
+	}
+}
+
+// russianPluralCategory implements CLDR's Russian rule for integers.
+func russianPluralCategory(n int) string {
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	mod10, mod100 := abs%10, abs%100
+	teens := mod100 >= 11 && mod100 <= 14
+
+	switch {
+	case mod10 == 1 && mod100 != 11:
+		return "one"
+	case mod10 >= 2 && mod10 <= 4 && !teens:
+		return "few"
+	case mod10 == 0 || (mod10 >= 5 && mod10 <= 9) || teens:
+		return "many"
+	default:
+		return "other"
+	}
+}
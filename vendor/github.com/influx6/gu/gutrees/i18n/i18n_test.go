@@ -0,0 +1,84 @@
+package i18n_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/i18n"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestNumberDiffersByLocale(t *testing.T) {
+	en := i18n.Number(1234567.89, "en-US")
+	de := i18n.Number(1234567.89, "de-DE")
+
+	if en.TextContent() != "1,234,567.89" {
+		t.Fatalf("\t%s\t Should format en-US with comma grouping and dot decimal, got %q", failed, en.TextContent())
+	}
+	if de.TextContent() != "1.234.567,89" {
+		t.Fatalf("\t%s\t Should format de-DE with dot grouping and comma decimal, got %q", failed, de.TextContent())
+	}
+	t.Logf("\t%s\t Should format the same number differently per locale", success)
+}
+
+func TestDateSetsDatetimeAndLocaleText(t *testing.T) {
+	when := time.Date(2026, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	en := i18n.Date(when, "en-US")
+	fr := i18n.Date(when, "fr-FR")
+
+	enText := en.Children()[0].(*gutrees.Element)
+	frText := fr.Children()[0].(*gutrees.Element)
+
+	if enText.TextContent() != "3/5/2026" {
+		t.Fatalf("\t%s\t Should format en-US as M/D/Y, got %q", failed, enText.TextContent())
+	}
+	if frText.TextContent() != "5/3/2026" {
+		t.Fatalf("\t%s\t Should format fr-FR as D/M/Y, got %q", failed, frText.TextContent())
+	}
+
+	dt, err := gutrees.GetAttr(en, "datetime")
+	if err != nil || dt.Value != when.Format(time.RFC3339) {
+		t.Fatalf("\t%s\t Should set datetime to the RFC3339 value", failed)
+	}
+	t.Logf("\t%s\t Should set datetime and format text conventionally per locale", success)
+}
+
+func TestPluralEnglish(t *testing.T) {
+	forms := i18n.PluralForms{One: "%d item", Other: "%d items"}
+
+	if got := i18n.Plural(1, forms, "en-US").TextContent(); got != "1 item" {
+		t.Fatalf("\t%s\t Should use the singular form for 1, got %q", failed, got)
+	}
+	if got := i18n.Plural(5, forms, "en-US").TextContent(); got != "5 items" {
+		t.Fatalf("\t%s\t Should use the plural form for 5, got %q", failed, got)
+	}
+	t.Logf("\t%s\t Should pick the English singular/plural form by count", success)
+}
+
+func TestPluralPolishCategories(t *testing.T) {
+	forms := i18n.PluralForms{
+		One:   "%d plik",
+		Few:   "%d pliki",
+		Many:  "%d plików",
+		Other: "%d pliku",
+	}
+
+	cases := map[int]string{
+		1:  "1 plik",
+		2:  "2 pliki",
+		5:  "5 plików",
+		12: "12 plików",
+		22: "22 pliki",
+	}
+
+	for count, want := range cases {
+		if got := i18n.Plural(count, forms, "pl-PL").TextContent(); got != want {
+			t.Fatalf("\t%s\t Should format %d as %q, got %q", failed, count, want, got)
+		}
+	}
+	t.Logf("\t%s\t Should pick Polish's one/few/many/other categories by count", success)
+}
@@ -0,0 +1,22 @@
+package gutrees
+
+import "fmt"
+
+// idCounter tracks per-prefix sequence numbers for the render pass in
+// progress.
+var idCounter = map[string]int{}
+
+// NextID returns a unique, render-deterministic id for the given prefix. Ids
+// are handed out sequentially per prefix, so as long as a tree is rebuilt the
+// same way each pass (the usual case for server/client hydration) the same
+// elements get the same ids. Call ResetIDs at the start of a render pass if
+// ids need to restart from zero, e.g. between independent top-level renders.
+func NextID(prefix string) string {
+	idCounter[prefix]++
+	return fmt.Sprintf("%s-%d", prefix, idCounter[prefix])
+}
+
+// ResetIDs clears the id sequence counters.
+func ResetIDs() {
+	idCounter = map[string]int{}
+}
@@ -0,0 +1,136 @@
+package gutrees
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IndentOptions controls which tags RenderIndent treats as inline (kept on
+// the same line as their surroundings, with no line breaks introduced
+// around them) and which are rendered verbatim (their content copied
+// through untouched, rather than reindented).
+type IndentOptions struct {
+	// InlineTags are rendered inline: opened, filled and closed on the
+	// same line as their siblings, the way a browser lays out inline-level
+	// elements.
+	InlineTags map[string]bool
+
+	// VerbatimTags have their content written out exactly as stored, with
+	// no indentation or line breaks introduced inside them.
+	VerbatimTags map[string]bool
+}
+
+// defaultInlineTags are the tags RenderIndent treats as inline unless
+// overridden, matching HTML's own inline-level elements.
+var defaultInlineTags = map[string]bool{
+	"a": true, "span": true, "strong": true, "em": true, "b": true,
+	"i": true, "u": true, "small": true, "code": true, "sub": true,
+	"sup": true, "abbr": true, "label": true, "mark": true, "time": true,
+	"img": true, "br": true, "wbr": true,
+}
+
+// defaultVerbatimTags are the tags RenderIndent leaves untouched unless
+// overridden: their content isn't markup to be reindented, it's
+// significant as-is.
+var defaultVerbatimTags = map[string]bool{
+	"pre": true, "textarea": true, "script": true, "style": true,
+}
+
+// DefaultIndentOptions returns the options RenderIndent uses when called
+// with the zero value of IndentOptions.
+func DefaultIndentOptions() IndentOptions {
+	return IndentOptions{
+		InlineTags:   defaultInlineTags,
+		VerbatimTags: defaultVerbatimTags,
+	}
+}
+
+// RenderIndent renders root as indented, human-readable HTML for
+// debugging, using opts to decide which tags are treated as inline (no
+// line breaks around them) and which are rendered verbatim (e.g. <pre>,
+// <textarea>). Passing the zero IndentOptions falls back to
+// DefaultIndentOptions.
+func RenderIndent(root *Element, opts IndentOptions) string {
+	if opts.InlineTags == nil && opts.VerbatimTags == nil {
+		opts = DefaultIndentOptions()
+	}
+
+	var b strings.Builder
+	writeIndented(&b, root, 0, opts, false)
+	return strings.TrimSpace(b.String())
+}
+
+// writeIndented writes e into b at depth, honoring opts. inline reports
+// whether e is being rendered as part of an inline run, in which case no
+// leading indent or trailing newline is written - the caller already
+// placed it on the right line.
+func writeIndented(b *strings.Builder, e *Element, depth int, opts IndentOptions, inline bool) {
+	if e.Name() == "text" {
+		b.WriteString(e.TextContent())
+		return
+	}
+
+	if !inline {
+		b.WriteString(strings.Repeat("  ", depth))
+	}
+
+	b.WriteString(fmt.Sprintf("<%s%s", e.Name(), SimpleAttrWriter.Print(e.Attributes())))
+
+	if e.AutoClosed() {
+		b.WriteString(" />")
+		if !inline {
+			b.WriteString("\n")
+		}
+		return
+	}
+	b.WriteString(">")
+
+	switch {
+	case opts.VerbatimTags[e.Name()]:
+		b.WriteString(elementText(e))
+
+	case opts.InlineTags[e.Name()]:
+		b.WriteString(e.textContent)
+		for _, ch := range e.Children() {
+			if ech, ok := ch.(*Element); ok {
+				writeIndented(b, ech, depth, opts, true)
+			}
+		}
+
+	default:
+		children := childElements(e)
+		if len(children) > 0 {
+			b.WriteString("\n")
+			for _, ech := range children {
+				if ech.Name() == "text" || opts.InlineTags[ech.Name()] {
+					b.WriteString(strings.Repeat("  ", depth+1))
+					writeIndented(b, ech, depth+1, opts, true)
+					b.WriteString("\n")
+					continue
+				}
+				writeIndented(b, ech, depth+1, opts, false)
+			}
+			b.WriteString(strings.Repeat("  ", depth))
+		} else {
+			b.WriteString(e.textContent)
+		}
+	}
+
+	b.WriteString(fmt.Sprintf("</%s>", e.Name()))
+	if !inline {
+		b.WriteString("\n")
+	}
+}
+
+// childElements returns e's children that are themselves *Element values,
+// in order, discarding any that aren't (matching how ElementWriter.Print
+// filters children when rendering).
+func childElements(e *Element) []*Element {
+	var out []*Element
+	for _, ch := range e.Children() {
+		if ech, ok := ch.(*Element); ok {
+			out = append(out, ech)
+		}
+	}
+	return out
+}
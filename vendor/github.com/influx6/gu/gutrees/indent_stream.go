@@ -0,0 +1,123 @@
+package gutrees
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// RenderIndentStream writes root to w as indented, human-readable HTML,
+// honoring opts exactly as RenderIndent does, but without ever buffering
+// the whole document - depth is tracked through the recursion, not through
+// an accumulated string, so a large tree costs O(depth) rather than
+// O(size) in memory. Passing the zero IndentOptions falls back to
+// DefaultIndentOptions. A verbatim element (pre, textarea, script, ...)
+// still suspends indentation for its content, same as RenderIndent - the
+// indentation just resumes once the writer moves past it.
+func RenderIndentStream(w io.Writer, root *Element, opts IndentOptions) error {
+	if opts.InlineTags == nil && opts.VerbatimTags == nil {
+		opts = DefaultIndentOptions()
+	}
+
+	return writeIndentedTo(w, root, 0, opts, true)
+}
+
+// writeIndentedTo is the streaming analogue of writeIndented: same
+// branching, same output, but written straight to w as it's produced
+// instead of into a strings.Builder. inline carries the same meaning as in
+// writeIndented - no leading indent or trailing newline for an element
+// being rendered as part of an inline run (the root call passes true,
+// matching the TrimSpace RenderIndent applies to trim the one newline a
+// top-level, non-inline write would otherwise leave trailing).
+func writeIndentedTo(w io.Writer, e *Element, depth int, opts IndentOptions, inline bool) error {
+	if e.Name() == "text" {
+		_, err := io.WriteString(w, e.TextContent())
+		return err
+	}
+
+	if !inline {
+		if _, err := io.WriteString(w, strings.Repeat("  ", depth)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, "<%s%s", e.Name(), SimpleAttrWriter.Print(e.Attributes())); err != nil {
+		return err
+	}
+
+	if e.AutoClosed() {
+		if _, err := io.WriteString(w, " />"); err != nil {
+			return err
+		}
+		if !inline {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if _, err := io.WriteString(w, ">"); err != nil {
+		return err
+	}
+
+	switch {
+	case opts.VerbatimTags[e.Name()]:
+		if _, err := io.WriteString(w, elementText(e)); err != nil {
+			return err
+		}
+
+	case opts.InlineTags[e.Name()]:
+		if _, err := io.WriteString(w, e.textContent); err != nil {
+			return err
+		}
+		for _, ch := range e.Children() {
+			if ech, ok := ch.(*Element); ok {
+				if err := writeIndentedTo(w, ech, depth, opts, true); err != nil {
+					return err
+				}
+			}
+		}
+
+	default:
+		children := childElements(e)
+		if len(children) > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+			for _, ech := range children {
+				if ech.Name() == "text" || opts.InlineTags[ech.Name()] {
+					if _, err := io.WriteString(w, strings.Repeat("  ", depth+1)); err != nil {
+						return err
+					}
+					if err := writeIndentedTo(w, ech, depth+1, opts, true); err != nil {
+						return err
+					}
+					if _, err := io.WriteString(w, "\n"); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := writeIndentedTo(w, ech, depth+1, opts, false); err != nil {
+					return err
+				}
+			}
+			if _, err := io.WriteString(w, strings.Repeat("  ", depth)); err != nil {
+				return err
+			}
+		} else {
+			if _, err := io.WriteString(w, e.textContent); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, "</%s>", e.Name()); err != nil {
+		return err
+	}
+	if !inline {
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package gutrees_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestRenderIndentStreamMatchesBufferedRenderIndent(t *testing.T) {
+	root := elems.Div()
+	p := elems.Paragraph()
+	gutrees.NewText("Hello ").Apply(p)
+	strong := elems.Strong()
+	gutrees.NewText("world").Apply(strong)
+	strong.Apply(p)
+	gutrees.NewText("!").Apply(p)
+	p.Apply(root)
+
+	pre := elems.Preformatted()
+	gutrees.NewText("line1\n  line2").Apply(pre)
+	pre.Apply(root)
+
+	opts := gutrees.DefaultIndentOptions()
+
+	buffered := gutrees.RenderIndent(root, opts)
+
+	var buf bytes.Buffer
+	if err := gutrees.RenderIndentStream(&buf, root, opts); err != nil {
+		t.Fatalf("\t%s\t Should stream without error, got %s", failed, err)
+	}
+
+	if buf.String() != buffered {
+		t.Fatalf("\t%s\t Should match buffered output:\nstreamed: %q\nbuffered: %q", failed, buf.String(), buffered)
+	}
+	t.Logf("\t%s\t Should produce identical output streaming as buffering", success)
+}
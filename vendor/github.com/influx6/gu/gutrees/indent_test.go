@@ -0,0 +1,48 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestRenderIndentMixesInlineAndBlockContent(t *testing.T) {
+	tree := elems.Div(
+		elems.Paragraph(
+			elems.Text("Hello "),
+			elems.Strong(elems.Text("world")),
+			elems.Text("!"),
+		),
+		elems.Preformatted(elems.Text("line1\n  line2")),
+	)
+
+	got := gutrees.RenderIndent(tree, gutrees.IndentOptions{})
+	want := "<div>\n" +
+		"  <p>\n" +
+		"    Hello \n" +
+		"    <strong>world</strong>\n" +
+		"    !\n" +
+		"  </p>\n" +
+		"  <pre>line1\n  line2</pre>\n" +
+		"</div>"
+
+	if got != want {
+		t.Fatalf("\t%s\t Should indent block content while keeping inline tags on the line, and leave <pre> verbatim.\ngot:\n%s\nwant:\n%s", failed, got, want)
+	}
+	t.Logf("\t%s\t Should indent block content while keeping inline tags on the line, and leave <pre> verbatim", success)
+}
+
+func TestRenderIndentHonorsCustomInlineTags(t *testing.T) {
+	tree := elems.Section(elems.Div(elems.Text("inline now")))
+
+	opts := gutrees.DefaultIndentOptions()
+	opts.InlineTags["div"] = true
+
+	got := gutrees.RenderIndent(tree, opts)
+	want := "<section>\n  <div>inline now</div>\n</section>"
+	if got != want {
+		t.Fatalf("\t%s\t Should treat <div> as inline when added to opts.InlineTags, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should honor caller-supplied InlineTags overrides", success)
+}
@@ -0,0 +1,105 @@
+package gutrees
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// AssetResolver loads the content and MIME type of a local asset
+// referenced by url, for RenderInlined to embed directly into the
+// rendered document. It's the caller's job to resolve url against
+// whatever it means locally - a filesystem path, an embedded asset
+// bundle, and so on.
+type AssetResolver func(url string) (content []byte, mimeType string, err error)
+
+// RenderInlined renders root to a standalone HTML string with its local
+// assets embedded directly into it, so the result needs no other files
+// alongside it to display correctly:
+//
+//   - a <link rel="stylesheet" href="..."> becomes a <style> holding the
+//     resolved CSS
+//   - a <script src="..."> becomes a <script> holding the resolved JS
+//   - an <img src="..."> has its src replaced with a data: URI
+//
+// An href or src that already looks external (an absolute "http://",
+// "https://" or protocol-relative "//" URL) is left untouched, since
+// there's nothing local to inline. root itself is left untouched;
+// RenderInlined renders an independent clone.
+func RenderInlined(root *Element, resolve AssetResolver) (string, error) {
+	cloned := root.Clone().(*Element)
+
+	var inlineErr error
+	Walk(cloned, func(e *Element) bool {
+		if inlineErr != nil {
+			return false
+		}
+
+		switch e.Name() {
+		case "link":
+			rel, err := GetAttr(e, "rel")
+			if err != nil || rel.Value != "stylesheet" {
+				return true
+			}
+			href, err := GetAttr(e, "href")
+			if err != nil || isExternalAssetURL(href.Value) {
+				return true
+			}
+
+			content, _, err := resolve(href.Value)
+			if err != nil {
+				inlineErr = err
+				return false
+			}
+
+			e.SetTagName("style", false)
+			e.RemoveAttr("rel")
+			e.RemoveAttr("href")
+			NewText(string(content)).Apply(e)
+
+		case "script":
+			src, err := GetAttr(e, "src")
+			if err != nil || isExternalAssetURL(src.Value) {
+				return true
+			}
+
+			content, _, err := resolve(src.Value)
+			if err != nil {
+				inlineErr = err
+				return false
+			}
+
+			e.RemoveAttr("src")
+			NewText(string(content)).Apply(e)
+
+		case "img":
+			src, err := GetAttr(e, "src")
+			if err != nil || isExternalAssetURL(src.Value) {
+				return true
+			}
+
+			content, mimeType, err := resolve(src.Value)
+			if err != nil {
+				inlineErr = err
+				return false
+			}
+
+			src.Value = "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(content)
+		}
+
+		return true
+	})
+
+	if inlineErr != nil {
+		return "", inlineErr
+	}
+
+	return string(RenderBytes(cloned)), nil
+}
+
+// isExternalAssetURL reports whether url points off-host, and so has
+// nothing local for RenderInlined to resolve and embed.
+func isExternalAssetURL(url string) bool {
+	return strings.HasPrefix(url, "http://") ||
+		strings.HasPrefix(url, "https://") ||
+		strings.HasPrefix(url, "//")
+}
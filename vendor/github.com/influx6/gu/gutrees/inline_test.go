@@ -0,0 +1,72 @@
+package gutrees_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func fakeResolver(assets map[string]string, mimeTypes map[string]string) gutrees.AssetResolver {
+	return func(url string) ([]byte, string, error) {
+		content, ok := assets[url]
+		if !ok {
+			return nil, "", errors.New("unknown asset: " + url)
+		}
+		return []byte(content), mimeTypes[url], nil
+	}
+}
+
+func TestRenderInlinedInlinesStylesheetAndImage(t *testing.T) {
+	tree := elems.Div(
+		elems.Link(attrs.Rel("stylesheet"), attrs.Href("/app.css")),
+		elems.Image(attrs.Src("/logo.png")),
+	)
+
+	resolver := fakeResolver(
+		map[string]string{"/app.css": "body{color:red}", "/logo.png": "PNGDATA"},
+		map[string]string{"/logo.png": "image/png"},
+	)
+
+	out, err := gutrees.RenderInlined(tree, resolver)
+	if err != nil {
+		t.Fatalf("\t%s\t Should inline without error, got %s", failed, err)
+	}
+
+	if strings.Contains(out, "app.css") || !strings.Contains(out, "body{color:red}") {
+		t.Fatalf("\t%s\t Should inline the stylesheet content into a <style> tag, got %q", failed, out)
+	}
+	if !strings.Contains(out, "data:image/png;base64,") {
+		t.Fatalf("\t%s\t Should inline the image as a data URI, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should inline a local stylesheet and image", success)
+}
+
+func TestRenderInlinedLeavesExternalURLsAlone(t *testing.T) {
+	tree := elems.Image(attrs.Src("https://cdn.example.com/logo.png"))
+
+	out, err := gutrees.RenderInlined(tree, fakeResolver(nil, nil))
+	if err != nil {
+		t.Fatalf("\t%s\t Should not try to resolve an external URL, got %s", failed, err)
+	}
+	if !strings.Contains(out, "https://cdn.example.com/logo.png") {
+		t.Fatalf("\t%s\t Should leave an external image src untouched, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should leave an external asset URL untouched", success)
+}
+
+func TestRenderInlinedDoesNotMutateOriginalTree(t *testing.T) {
+	tree := elems.Link(attrs.Rel("stylesheet"), attrs.Href("/app.css"))
+
+	if _, err := gutrees.RenderInlined(tree, fakeResolver(map[string]string{"/app.css": "body{color:red}"}, nil)); err != nil {
+		t.Fatalf("\t%s\t Should inline without error, got %s", failed, err)
+	}
+
+	if tree.TagName() != "link" {
+		t.Fatalf("\t%s\t Should leave the original tree untouched, got tag %q", failed, tree.TagName())
+	}
+	t.Logf("\t%s\t Should not mutate the original tree", success)
+}
@@ -0,0 +1,36 @@
+package gutrees
+
+// Lazy returns an Appliable that defers building an expensive subtree
+// until it's actually rendered, instead of building it eagerly just to
+// throw it away when the branch it sits in is never shown (e.g. an
+// unopened tab's panel). fn is called at most once per placeholder - the
+// first render caches the result, so reconciling against it on later
+// renders doesn't rebuild the subtree again.
+//
+// Only the render path (Print and the streaming writers) resolves a Lazy
+// placeholder; a tree walker that inspects Children() directly (Walk,
+// RenderText, Diff, ...) before the tree has been rendered will see an
+// empty "lazy" element rather than fn's result.
+func Lazy(fn func() *Element) Appliable {
+	return &lazyApplier{fn: fn}
+}
+
+type lazyApplier struct {
+	fn func() *Element
+}
+
+// Apply adds a placeholder element carrying fn onto m, without calling fn.
+func (l *lazyApplier) Apply(m Markup) {
+	e := NewElement("lazy", false)
+	e.lazyFn = l.fn
+	e.Apply(m)
+}
+
+// resolveLazy returns the subtree e's lazyFn builds, calling it the first
+// time and caching the result for every later call.
+func (e *Element) resolveLazy() *Element {
+	if e.lazyResult == nil && e.lazyFn != nil {
+		e.lazyResult = e.lazyFn()
+	}
+	return e.lazyResult
+}
@@ -0,0 +1,43 @@
+package gutrees
+
+// LazyMedia applies loading="lazy" to every descendant img/iframe within
+// markup that doesn't already carry an explicit loading attribute, so a
+// whole section of a page can opt into lazy-loading without annotating each
+// element by hand. An element marked eager (or any other explicit value) is
+// left untouched.
+func LazyMedia(markup ...Appliable) Appliable {
+	return &lazyMedia{markup: markup}
+}
+
+type lazyMedia struct {
+	markup []Appliable
+}
+
+// Apply builds the wrapped markup into a scratch container, stamps
+// loading="lazy" onto its bare img/iframe descendants, then transfers the
+// finished children across to m, so the transform only ever touches markup
+// passed to LazyMedia and not siblings already applied to m.
+func (l *lazyMedia) Apply(m Markup) {
+	scratch := NewElement("div", false)
+
+	for _, markup := range l.markup {
+		markup.Apply(scratch)
+	}
+
+	applyLazyMedia(scratch)
+
+	for _, child := range scratch.Children() {
+		child.Apply(m)
+	}
+}
+
+func applyLazyMedia(e *Element) {
+	Walk(e, func(el *Element) bool {
+		if el.Name() == "img" || el.Name() == "iframe" {
+			if _, err := GetAttr(el, "loading"); err != nil {
+				(&Attribute{Name: "loading", Value: "lazy"}).Apply(el)
+			}
+		}
+		return true
+	})
+}
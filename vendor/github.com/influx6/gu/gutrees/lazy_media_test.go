@@ -0,0 +1,45 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestLazyMediaAddsLoadingOnlyWhereMissing(t *testing.T) {
+	eager := elems.Image()
+	(&gutrees.Attribute{Name: "loading", Value: "eager"}).Apply(eager)
+
+	div := elems.Div(
+		gutrees.LazyMedia(
+			elems.Image(),
+			elems.InlineFrame(),
+			eager,
+			elems.Div(elems.Image()),
+		),
+	)
+
+	children := div.Children()
+
+	img := children[0].(*gutrees.Element)
+	iframe := children[1].(*gutrees.Element)
+	eagerImg := children[2].(*gutrees.Element)
+	nested := children[3].(*gutrees.Element).Children()[0].(*gutrees.Element)
+
+	if n, err := gutrees.GetAttr(img, "loading"); err != nil || n.Value != "lazy" {
+		t.Fatalf("\t%s\t Should add loading=\"lazy\" to a bare img", failed)
+	}
+	if n, err := gutrees.GetAttr(iframe, "loading"); err != nil || n.Value != "lazy" {
+		t.Fatalf("\t%s\t Should add loading=\"lazy\" to a bare iframe", failed)
+	}
+	if n, err := gutrees.GetAttr(nested, "loading"); err != nil || n.Value != "lazy" {
+		t.Fatalf("\t%s\t Should add loading=\"lazy\" to a nested descendant img", failed)
+	}
+	t.Logf("\t%s\t Should add loading=\"lazy\" to bare descendant media", success)
+
+	if n, err := gutrees.GetAttr(eagerImg, "loading"); err != nil || n.Value != "eager" {
+		t.Fatalf("\t%s\t Should not override an explicit loading attribute, got %+v, %s", failed, n, err)
+	}
+	t.Logf("\t%s\t Should not override an explicit loading attribute", success)
+}
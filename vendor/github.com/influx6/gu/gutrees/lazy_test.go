@@ -0,0 +1,50 @@
+package gutrees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestLazyDefersBuildingUntilRender(t *testing.T) {
+	var called bool
+	root := gutrees.NewElement("div", false)
+
+	gutrees.Lazy(func() *gutrees.Element {
+		called = true
+		return gutrees.NewElement("span", false)
+	}).Apply(root)
+
+	if called {
+		t.Fatalf("\t%s\t Should not call fn just from applying Lazy", failed)
+	}
+	t.Logf("\t%s\t Should defer calling fn until render", success)
+
+	out := string(gutrees.RenderBytes(root))
+	if !called {
+		t.Fatalf("\t%s\t Should call fn once the tree is rendered", failed)
+	}
+	if !strings.Contains(out, "<span") {
+		t.Fatalf("\t%s\t Should render fn's result in place of the placeholder, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should render fn's subtree once rendering actually happens", success)
+}
+
+func TestLazyCachesResultAcrossRenders(t *testing.T) {
+	calls := 0
+	root := gutrees.NewElement("div", false)
+
+	gutrees.Lazy(func() *gutrees.Element {
+		calls++
+		return gutrees.NewElement("span", false)
+	}).Apply(root)
+
+	gutrees.RenderBytes(root)
+	gutrees.RenderBytes(root)
+
+	if calls != 1 {
+		t.Fatalf("\t%s\t Should call fn at most once across repeated renders, got %d calls", failed, calls)
+	}
+	t.Logf("\t%s\t Should cache fn's result after the first render", success)
+}
@@ -0,0 +1,128 @@
+package gutrees
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Link is one URL reference extracted from a tree by ExtractLinks.
+type Link struct {
+	// Kind identifies what kind of reference this is: "anchor", "image",
+	// "script" or "stylesheet".
+	Kind string
+
+	// URL is the reference's target, resolved against the tree's
+	// <base href> if it has one.
+	URL string
+
+	// Text is the anchor's text content. It's empty for every Kind but
+	// "anchor".
+	Text string
+
+	// Rel is the anchor's rel attribute, if any. It's empty for every
+	// Kind but "anchor".
+	Rel string
+}
+
+// ExtractLinks walks root and its descendants and returns every URL
+// reference it finds: an anchor's href (with its text and rel), an
+// img's src, a script's src, and a stylesheet link's href. Each URL is
+// resolved against root's <base href>, if it has one; otherwise it's
+// returned as found in the tree. Elements that carry no URL (an anchor
+// with no href, a non-stylesheet link) are skipped.
+func ExtractLinks(root *Element) []Link {
+	base := findBaseHref(root)
+
+	var links []Link
+
+	Walk(root, func(e *Element) bool {
+		switch e.Name() {
+		case "a":
+			href, err := GetAttr(e, "href")
+			if err != nil {
+				return true
+			}
+
+			var rel string
+			if a, err := GetAttr(e, "rel"); err == nil {
+				rel = a.Value
+			}
+
+			links = append(links, Link{
+				Kind: "anchor",
+				URL:  resolveAgainstBase(base, href.Value),
+				Text: elementText(e),
+				Rel:  rel,
+			})
+
+		case "img":
+			if src, err := GetAttr(e, "src"); err == nil {
+				links = append(links, Link{Kind: "image", URL: resolveAgainstBase(base, src.Value)})
+			}
+
+		case "script":
+			if src, err := GetAttr(e, "src"); err == nil {
+				links = append(links, Link{Kind: "script", URL: resolveAgainstBase(base, src.Value)})
+			}
+
+		case "link":
+			rel, relErr := GetAttr(e, "rel")
+			href, hrefErr := GetAttr(e, "href")
+			if relErr == nil && hrefErr == nil && rel.Value == "stylesheet" {
+				links = append(links, Link{Kind: "stylesheet", URL: resolveAgainstBase(base, href.Value)})
+			}
+		}
+
+		return true
+	})
+
+	return links
+}
+
+// findBaseHref returns root's <base href> value, or "" if it has none.
+func findBaseHref(root *Element) string {
+	var base string
+
+	Walk(root, func(e *Element) bool {
+		if e.Name() != "base" {
+			return true
+		}
+		if href, err := GetAttr(e, "href"); err == nil {
+			base = href.Value
+		}
+		return true
+	})
+
+	return base
+}
+
+// elementText concatenates the text content of e's text-node descendants,
+// in document order, the way a browser's textContent would.
+func elementText(e *Element) string {
+	var parts []string
+	WalkText(e, func(text *Element) {
+		parts = append(parts, text.TextContent())
+	})
+	return strings.Join(parts, "")
+}
+
+// resolveAgainstBase resolves ref against base the way a browser resolves
+// a relative URL against a page's <base href>. If base is empty, or
+// either fails to parse, ref is returned unchanged.
+func resolveAgainstBase(base, ref string) string {
+	if base == "" {
+		return ref
+	}
+
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return ref
+	}
+
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+
+	return baseURL.ResolveReference(refURL).String()
+}
@@ -0,0 +1,49 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestExtractLinksFindsAnchorsAndImage(t *testing.T) {
+	tree := elems.Div(
+		elems.Anchor(attrs.Href("/about"), attrs.Rel("nofollow"), elems.Text("About")),
+		elems.Anchor(attrs.Href("https://example.com"), elems.Text("External")),
+		elems.Image(attrs.Src("/logo.png")),
+	)
+
+	links := gutrees.ExtractLinks(tree)
+	if len(links) != 3 {
+		t.Fatalf("\t%s\t Should find 3 links, got %d (%+v)", failed, len(links), links)
+	}
+
+	if links[0].Kind != "anchor" || links[0].URL != "/about" || links[0].Text != "About" || links[0].Rel != "nofollow" {
+		t.Fatalf("\t%s\t Should describe the first anchor fully, got %+v", failed, links[0])
+	}
+	if links[1].Kind != "anchor" || links[1].URL != "https://example.com" || links[1].Text != "External" {
+		t.Fatalf("\t%s\t Should describe the second anchor, got %+v", failed, links[1])
+	}
+	if links[2].Kind != "image" || links[2].URL != "/logo.png" {
+		t.Fatalf("\t%s\t Should describe the image, got %+v", failed, links[2])
+	}
+	t.Logf("\t%s\t Should extract anchors and an image reference", success)
+}
+
+func TestExtractLinksResolvesAgainstBaseHref(t *testing.T) {
+	root := gutrees.NewElement("html", false)
+	head := gutrees.NewElement("head", false)
+	elems.Base(attrs.Href("https://example.com/docs/")).Apply(head)
+	body := gutrees.NewElement("body", false)
+	elems.Anchor(attrs.Href("guide.html"), elems.Text("Guide")).Apply(body)
+	head.Apply(root)
+	body.Apply(root)
+
+	links := gutrees.ExtractLinks(root)
+	if len(links) != 1 || links[0].URL != "https://example.com/docs/guide.html" {
+		t.Fatalf("\t%s\t Should resolve a relative href against <base href>, got %+v", failed, links)
+	}
+	t.Logf("\t%s\t Should resolve relative URLs against <base href>", success)
+}
@@ -0,0 +1,57 @@
+package gutrees
+
+import "strings"
+
+// SecureExternalLinks walks root and its descendants, and for every
+// anchor ("a") element with target="_blank" ensures its rel attribute
+// contains "noopener" and "noreferrer" - without them, the new tab a
+// target="_blank" link opens can reach back into window.opener and
+// navigate the page that linked to it. Any existing rel tokens (e.g.
+// "nofollow") are kept; the two tokens are only appended if missing.
+// Anchors without target="_blank", and non-anchor elements, are left
+// untouched.
+//
+// SecureExternalLinks mutates the tree in place; it is a no-op, logging
+// a warning, on an element frozen with Freeze.
+func SecureExternalLinks(root *Element) {
+	Walk(root, func(e *Element) bool {
+		if e.Name() != "a" {
+			return true
+		}
+
+		target, err := GetAttr(e, "target")
+		if err != nil || target.Value != "_blank" {
+			return true
+		}
+
+		if e.rejectIfFrozen("SecureExternalLinks") {
+			return true
+		}
+
+		rel, err := GetAttr(e, "rel")
+		if err != nil {
+			(&Attribute{Name: "rel", Value: "noopener noreferrer"}).Apply(e)
+			return true
+		}
+
+		rel.Value = addMissingTokens(rel.Value, "noopener", "noreferrer")
+		return true
+	})
+}
+
+// addMissingTokens appends any of tokens not already present (as a
+// whole, space-separated word) in val, and returns the result.
+func addMissingTokens(val string, tokens ...string) string {
+	existing := make(map[string]bool)
+	for _, tok := range strings.Fields(val) {
+		existing[tok] = true
+	}
+
+	for _, tok := range tokens {
+		if !existing[tok] {
+			val += " " + tok
+		}
+	}
+
+	return strings.TrimSpace(val)
+}
@@ -0,0 +1,44 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestSecureExternalLinksAddsRelToBlankTarget(t *testing.T) {
+	tree := elems.Anchor(attrs.Href("https://example.com"), &gutrees.Attribute{Name: "target", Value: "_blank"})
+
+	gutrees.SecureExternalLinks(tree)
+
+	rel, err := gutrees.GetAttr(tree, "rel")
+	if err != nil || rel.Value != "noopener noreferrer" {
+		t.Fatalf("\t%s\t Should add rel=noopener noreferrer, got %q (err=%v)", failed, rel, err)
+	}
+	t.Logf("\t%s\t Should add noopener/noreferrer to a target=_blank anchor", success)
+}
+
+func TestSecureExternalLinksMergesWithExistingRel(t *testing.T) {
+	tree := elems.Anchor(&gutrees.Attribute{Name: "target", Value: "_blank"}, attrs.Rel("nofollow"))
+
+	gutrees.SecureExternalLinks(tree)
+
+	rel, err := gutrees.GetAttr(tree, "rel")
+	if err != nil || rel.Value != "nofollow noopener noreferrer" {
+		t.Fatalf("\t%s\t Should merge new tokens with existing rel, got %q (err=%v)", failed, rel, err)
+	}
+	t.Logf("\t%s\t Should merge noopener/noreferrer into an existing rel value", success)
+}
+
+func TestSecureExternalLinksLeavesNonBlankAnchorAlone(t *testing.T) {
+	tree := elems.Anchor(attrs.Href("/local"))
+
+	gutrees.SecureExternalLinks(tree)
+
+	if _, err := gutrees.GetAttr(tree, "rel"); err == nil {
+		t.Fatalf("\t%s\t Should not add a rel attribute to an anchor without target=_blank", failed)
+	}
+	t.Logf("\t%s\t Should leave an anchor without target=_blank untouched", success)
+}
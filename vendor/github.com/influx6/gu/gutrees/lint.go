@@ -0,0 +1,83 @@
+package gutrees
+
+// Issue describes one problem Lint found in a tree: Rule identifies which
+// check raised it, Message explains why, and Element is the offending
+// node, so a caller can report its position or render it for context.
+type Issue struct {
+	Rule    string
+	Message string
+	Element *Element
+}
+
+// formControlTags are the elements a form label should be paired with.
+var formControlTags = map[string]bool{
+	"input": true, "select": true, "textarea": true,
+}
+
+// Lint walks root for common accessibility and SEO mistakes: an <img>
+// with no "alt", a <button> with no "type", an <a> with no text content,
+// and a form control with no label referencing it (via a label's
+// "htmlFor" matching the control's "id"). It's meant for development-time
+// use - a linter reporting the same issue on every request in production
+// would just be wasted work.
+func Lint(root *Element) []Issue {
+	labeledIDs := make(map[string]bool)
+	Walk(root, func(e *Element) bool {
+		if e.Name() != "label" {
+			return true
+		}
+		if htmlFor, err := GetAttr(e, "htmlFor"); err == nil {
+			labeledIDs[htmlFor.Value] = true
+		}
+		return true
+	})
+
+	var issues []Issue
+
+	Walk(root, func(e *Element) bool {
+		switch e.Name() {
+		case "img":
+			if _, err := GetAttr(e, "alt"); err != nil {
+				issues = append(issues, Issue{
+					Rule:    "missing-alt",
+					Message: "<img> has no \"alt\" attribute",
+					Element: e,
+				})
+			}
+
+		case "button":
+			if _, err := GetAttr(e, "type"); err != nil {
+				issues = append(issues, Issue{
+					Rule:    "missing-button-type",
+					Message: "<button> has no \"type\" attribute",
+					Element: e,
+				})
+			}
+
+		case "a":
+			if elementText(e) == "" {
+				issues = append(issues, Issue{
+					Rule:    "empty-link-text",
+					Message: "<a> has no text content",
+					Element: e,
+				})
+			}
+
+		default:
+			if formControlTags[e.Name()] {
+				id, err := GetAttr(e, "id")
+				if err != nil || !labeledIDs[id.Value] {
+					issues = append(issues, Issue{
+						Rule:    "unlabeled-form-control",
+						Message: "form control has no label referencing its \"id\"",
+						Element: e,
+					})
+				}
+			}
+		}
+
+		return true
+	})
+
+	return issues
+}
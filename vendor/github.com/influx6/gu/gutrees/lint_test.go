@@ -0,0 +1,65 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestLintFlagsMissingAlt(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+	img := gutrees.NewElement("img", true)
+	img.Apply(root)
+
+	issues := gutrees.Lint(root)
+
+	var found bool
+	for _, issue := range issues {
+		if issue.Rule == "missing-alt" && issue.Element == img {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("\t%s\t Should flag an <img> with no alt attribute, got %+v", failed, issues)
+	}
+	t.Logf("\t%s\t Should flag a missing alt attribute on an image", success)
+}
+
+func TestLintFlagsEmptyLinkText(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+	link := gutrees.NewElement("a", false)
+	(&gutrees.Attribute{Name: "href", Value: "/"}).Apply(link)
+	link.Apply(root)
+
+	issues := gutrees.Lint(root)
+
+	var found bool
+	for _, issue := range issues {
+		if issue.Rule == "empty-link-text" && issue.Element == link {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("\t%s\t Should flag an <a> with no text content, got %+v", failed, issues)
+	}
+	t.Logf("\t%s\t Should flag a link with no text content", success)
+}
+
+func TestLintIgnoresWellFormedMarkup(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+
+	img := gutrees.NewElement("img", true)
+	(&gutrees.Attribute{Name: "alt", Value: "a cat"}).Apply(img)
+	img.Apply(root)
+
+	link := gutrees.NewElement("a", false)
+	(&gutrees.Attribute{Name: "href", Value: "/"}).Apply(link)
+	gutrees.NewText("Home").Apply(link)
+	link.Apply(root)
+
+	issues := gutrees.Lint(root)
+	if len(issues) != 0 {
+		t.Fatalf("\t%s\t Should not flag well-formed markup, got %+v", failed, issues)
+	}
+	t.Logf("\t%s\t Should not flag markup that already meets the rules", success)
+}
@@ -0,0 +1,122 @@
+// Package markdown renders a small, common subset of Markdown (headings,
+// paragraphs, lists, links, emphasis and fenced code blocks) into a managed
+// gutrees tree instead of raw HTML.
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+var (
+	headingRe = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	listRe    = regexp.MustCompile(`^[-*]\s+(.*)$`)
+	linkRe    = regexp.MustCompile(`\[([^\]]*)\]\(([^)]*)\)`)
+	emphRe    = regexp.MustCompile(`[*_]([^*_]+)[*_]`)
+)
+
+// Render converts a Markdown document into a fragment of elems.* nodes
+// (headings, paragraphs, lists, links, code blocks and emphasis), since a
+// document is usually made up of more than one top-level block.
+func Render(src string) (*gutrees.Element, error) {
+	lines := strings.Split(src, "\n")
+
+	var blocks []gutrees.Appliable
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			continue
+
+		case strings.HasPrefix(line, "```"):
+			var code []string
+			i++
+			for i < len(lines) && !strings.HasPrefix(lines[i], "```") {
+				code = append(code, lines[i])
+				i++
+			}
+			blocks = append(blocks, elems.Preformatted(elems.Code(elems.Text(strings.Join(code, "\n")))))
+
+		case headingRe.MatchString(line):
+			m := headingRe.FindStringSubmatch(line)
+			blocks = append(blocks, heading(len(m[1]), inline(m[2])))
+
+		case listRe.MatchString(line):
+			var items []gutrees.Appliable
+			for i < len(lines) && listRe.MatchString(lines[i]) {
+				m := listRe.FindStringSubmatch(lines[i])
+				items = append(items, elems.ListItem(inline(m[1])...))
+				i++
+			}
+			i--
+			blocks = append(blocks, elems.UnorderedList(items...))
+
+		default:
+			var para []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+				para = append(para, lines[i])
+				i++
+			}
+			i--
+			blocks = append(blocks, elems.Paragraph(inline(strings.Join(para, " "))...))
+		}
+	}
+
+	return gutrees.NewFragment(blocks...), nil
+}
+
+// heading returns the right elems.HeaderN constructor for the given level.
+func heading(level int, markup []gutrees.Appliable) *gutrees.Element {
+	switch level {
+	case 1:
+		return elems.Header1(markup...)
+	case 2:
+		return elems.Header2(markup...)
+	case 3:
+		return elems.Header3(markup...)
+	case 4:
+		return elems.Header4(markup...)
+	case 5:
+		return elems.Header5(markup...)
+	default:
+		return elems.Header6(markup...)
+	}
+}
+
+// inline parses a line of text for links and emphasis, returning a list of
+// markup to apply to the containing block. Plain text is passed through
+// elems.Text, which owns the escaping for its content.
+func inline(text string) []gutrees.Appliable {
+	var out []gutrees.Appliable
+
+	for len(text) > 0 {
+		linkLoc := linkRe.FindStringSubmatchIndex(text)
+		emphLoc := emphRe.FindStringSubmatchIndex(text)
+
+		switch {
+		case linkLoc != nil && (emphLoc == nil || linkLoc[0] <= emphLoc[0]):
+			out = append(out, elems.Text(text[:linkLoc[0]]))
+			label := text[linkLoc[2]:linkLoc[3]]
+			href := text[linkLoc[4]:linkLoc[5]]
+			out = append(out, elems.Anchor(attrs.Href(href), elems.Text(label)))
+			text = text[linkLoc[1]:]
+
+		case emphLoc != nil:
+			out = append(out, elems.Text(text[:emphLoc[0]]))
+			out = append(out, elems.Emphasis(elems.Text(text[emphLoc[2]:emphLoc[3]])))
+			text = text[emphLoc[1]:]
+
+		default:
+			out = append(out, elems.Text(text))
+			text = ""
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,53 @@
+package markdown_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/markdown"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func render(t *testing.T, src string) string {
+	tree, err := markdown.Render(src)
+	if err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	out, err := gutrees.SimpleMarkupWriter.Write(tree)
+	if err != nil {
+		t.Fatalf("\t%s\t Should write without error, got %s", failed, err)
+	}
+
+	return out
+}
+
+func TestHeading(t *testing.T) {
+	out := render(t, "# Title")
+
+	if !strings.Contains(out, "<h1") || !strings.Contains(out, "Title") {
+		t.Fatalf("\t%s\t Should render a h1 heading, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should render a h1 heading", success)
+}
+
+func TestList(t *testing.T) {
+	out := render(t, "- one\n- two")
+
+	if !strings.Contains(out, "<ul") || !strings.Contains(out, "<li") || !strings.Contains(out, "one") || !strings.Contains(out, "two") {
+		t.Fatalf("\t%s\t Should render a list with both items, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should render a list with both items", success)
+}
+
+func TestFencedCodeBlock(t *testing.T) {
+	out := render(t, "```\nfmt.Println(\"hi\")\n```")
+
+	if !strings.Contains(out, "<pre") || !strings.Contains(out, "<code") || !strings.Contains(out, `fmt.Println(&#34;hi&#34;)`) {
+		t.Fatalf("\t%s\t Should render a preformatted code block, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should render a preformatted code block", success)
+}
@@ -0,0 +1,52 @@
+package gutrees
+
+import "reflect"
+
+// Memoized wraps a Renderable along with the dependency values its last
+// render was computed from.
+type Memoized struct {
+	r           Renderable
+	deps        []interface{}
+	rendered    *Element
+	hasRendered bool
+}
+
+// Memo wraps a Renderable so that repeated Render calls reuse the last
+// rendered subtree until SetDeps is called with deps that differ
+// (compared with reflect.DeepEqual) from the ones last rendered with.
+// Keep the returned *Memoized around across render passes, calling
+// SetDeps with the current deps each time you'd otherwise re-create the
+// wrapper, to actually skip re-rendering an unchanged component -
+// rebuilding the wrapper itself via Memo on every pass discards
+// hasRendered along with it and defeats the cache entirely. deps should
+// be value-comparable; pointers and funcs will only be considered equal
+// to themselves.
+func Memo(r Renderable, deps ...interface{}) *Memoized {
+	return &Memoized{r: r, deps: deps}
+}
+
+// SetDeps updates the deps this memo compares against, invalidating the
+// cached subtree so the next Render call re-renders if deps differs from
+// the deps last rendered with. It returns m so it can be chained with
+// Render: memo.SetDeps(theme, count).Render().
+func (m *Memoized) SetDeps(deps ...interface{}) *Memoized {
+	if !reflect.DeepEqual(m.deps, deps) {
+		m.deps = deps
+		m.hasRendered = false
+	}
+	return m
+}
+
+// Render returns the cached subtree if deps are unchanged since the last
+// render, otherwise it re-renders the wrapped component and caches the
+// result. The returned subtree is safe to reuse across renders since it
+// only feeds into the normal Reconcile/diff path.
+func (m *Memoized) Render() *Element {
+	if m.hasRendered {
+		return m.rendered
+	}
+
+	m.rendered = m.r.Render()
+	m.hasRendered = true
+	return m.rendered
+}
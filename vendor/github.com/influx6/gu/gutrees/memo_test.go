@@ -0,0 +1,43 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+type spyRenderable struct {
+	calls int
+}
+
+func (s *spyRenderable) Render() *gutrees.Element {
+	s.calls++
+	return elems.Div()
+}
+
+func TestMemoSkipsUnchangedDeps(t *testing.T) {
+	spy := &spyRenderable{}
+	memo := gutrees.Memo(spy, "theme", 4)
+
+	memo.Render()
+	memo.SetDeps("theme", 4).Render()
+
+	if spy.calls != 1 {
+		t.Fatalf("\t%s\t Should render the wrapped component once across two renders with identical deps, got %d", failed, spy.calls)
+	}
+	t.Logf("\t%s\t Should render the wrapped component once across two renders with identical deps", success)
+}
+
+func TestMemoRerendersOnChangedDeps(t *testing.T) {
+	spy := &spyRenderable{}
+	memo := gutrees.Memo(spy, "theme", 4)
+
+	memo.Render()
+	memo.SetDeps("theme", 5).Render()
+
+	if spy.calls != 2 {
+		t.Fatalf("\t%s\t Should re-render the wrapped component when deps change, got %d calls", failed, spy.calls)
+	}
+	t.Logf("\t%s\t Should re-render the wrapped component when deps change", success)
+}
@@ -0,0 +1,82 @@
+// Package meta builds the <meta> tags used in document heads for social
+// previews (Open Graph, Twitter Cards) from small, plain structs instead of
+// hand-rolled property/content pairs.
+package meta
+
+import (
+	"html"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// OGData holds the fields used to build Open Graph meta tags. Empty fields
+// are omitted from the output.
+type OGData struct {
+	Title       string
+	Description string
+	Image       string
+	URL         string
+	Type        string
+}
+
+// OpenGraph renders one elems.Meta per non-empty field of data, using the
+// matching "og:*" property. Values are HTML-escaped into the content
+// attribute here, since the writer prints attribute values as given.
+func OpenGraph(data OGData) []*gutrees.Element {
+	pairs := []struct {
+		property string
+		value    string
+	}{
+		{"og:title", data.Title},
+		{"og:description", data.Description},
+		{"og:image", data.Image},
+		{"og:url", data.URL},
+		{"og:type", data.Type},
+	}
+
+	var tags []*gutrees.Element
+	for _, pair := range pairs {
+		if pair.value == "" {
+			continue
+		}
+		tags = append(tags, elems.Meta(attrs.Property(pair.property), attrs.Content(html.EscapeString(pair.value))))
+	}
+
+	return tags
+}
+
+// TwitterData holds the fields used to build Twitter Card meta tags. Empty
+// fields are omitted from the output.
+type TwitterData struct {
+	Card        string
+	Title       string
+	Description string
+	Image       string
+}
+
+// Twitter renders one elems.Meta per non-empty field of data, using the
+// matching "twitter:*" name. Values are HTML-escaped the same way as in
+// OpenGraph.
+func Twitter(data TwitterData) []*gutrees.Element {
+	pairs := []struct {
+		name  string
+		value string
+	}{
+		{"twitter:card", data.Card},
+		{"twitter:title", data.Title},
+		{"twitter:description", data.Description},
+		{"twitter:image", data.Image},
+	}
+
+	var tags []*gutrees.Element
+	for _, pair := range pairs {
+		if pair.value == "" {
+			continue
+		}
+		tags = append(tags, elems.Meta(attrs.Name(pair.name), attrs.Content(html.EscapeString(pair.value))))
+	}
+
+	return tags
+}
@@ -0,0 +1,52 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/meta"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestOpenGraphPopulatedStruct(t *testing.T) {
+	tags := meta.OpenGraph(meta.OGData{
+		Title: "A Title",
+		Image: "a.jpg",
+	})
+
+	if len(tags) != 2 {
+		t.Fatalf("\t%s\t Should only emit tags for non-empty fields, got %d", failed, len(tags))
+	}
+
+	property, err := gutrees.GetAttr(tags[0], "property")
+	if err != nil || property.Value != "og:title" {
+		t.Fatalf("\t%s\t Should set property=og:title on the first tag", failed)
+	}
+	content, err := gutrees.GetAttr(tags[0], "content")
+	if err != nil || content.Value != "A Title" {
+		t.Fatalf("\t%s\t Should set content to the title value", failed)
+	}
+	t.Logf("\t%s\t Should emit the correct property/content pair for Title", success)
+
+	property, err = gutrees.GetAttr(tags[1], "property")
+	if err != nil || property.Value != "og:image" {
+		t.Fatalf("\t%s\t Should set property=og:image on the second tag", failed)
+	}
+	t.Logf("\t%s\t Should emit the correct property/content pair for Image", success)
+}
+
+func TestTwitterPopulatedStruct(t *testing.T) {
+	tags := meta.Twitter(meta.TwitterData{Card: "summary", Title: "A Title"})
+
+	if len(tags) != 2 {
+		t.Fatalf("\t%s\t Should only emit tags for non-empty fields, got %d", failed, len(tags))
+	}
+
+	name, err := gutrees.GetAttr(tags[0], "name")
+	if err != nil || name.Value != "twitter:card" {
+		t.Fatalf("\t%s\t Should set name=twitter:card on the first tag", failed)
+	}
+	t.Logf("\t%s\t Should emit the correct name/content pair for Card", success)
+}
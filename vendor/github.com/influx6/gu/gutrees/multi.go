@@ -0,0 +1,38 @@
+package gutrees
+
+import (
+	"fmt"
+	"io"
+)
+
+// RenderMulti renders e once, fanning the output out to every writer in
+// writers - walking the tree a single time rather than calling
+// RenderStreaming once per destination, for the common "send this to the
+// response and a cache file too" case. If any writer errors, rendering
+// stops immediately and the error is wrapped with that writer's index in
+// writers, since a bare io.MultiWriter gives no way to tell which
+// destination failed.
+func RenderMulti(e *Element, writers ...io.Writer) error {
+	return RenderStreaming(&indexedMultiWriter{writers: writers}, e, nil)
+}
+
+// indexedMultiWriter fans a Write out to each of writers in order,
+// stopping at the first failure and naming its index - the same
+// fan-out io.MultiWriter performs, but with enough context in the error
+// for RenderMulti's callers to act on it.
+type indexedMultiWriter struct {
+	writers []io.Writer
+}
+
+func (m *indexedMultiWriter) Write(p []byte) (int, error) {
+	for i, w := range m.writers {
+		n, err := w.Write(p)
+		if err != nil {
+			return n, fmt.Errorf("gutrees: writer %d: %w", i, err)
+		}
+		if n != len(p) {
+			return n, fmt.Errorf("gutrees: writer %d: %w", i, io.ErrShortWrite)
+		}
+	}
+	return len(p), nil
+}
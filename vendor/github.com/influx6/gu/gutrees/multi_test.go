@@ -0,0 +1,46 @@
+package gutrees_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestRenderMultiWritesIdenticalContentToEachWriter(t *testing.T) {
+	div := gutrees.NewElement("div", false)
+	gutrees.NewText("hello").Apply(div)
+
+	var a, b bytes.Buffer
+	if err := gutrees.RenderMulti(div, &a, &b); err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	if a.String() != b.String() || a.Len() == 0 {
+		t.Fatalf("\t%s\t Should write identical content to both writers, got %q and %q", failed, a.String(), b.String())
+	}
+	t.Logf("\t%s\t Should render once and fan the output out to every writer", success)
+}
+
+type failingWriter struct{ err error }
+
+func (f failingWriter) Write(p []byte) (int, error) { return 0, f.err }
+
+func TestRenderMultiReportsFailingWriterIndex(t *testing.T) {
+	div := gutrees.NewElement("div", false)
+	gutrees.NewText("hello").Apply(div)
+
+	boom := errors.New("boom")
+	var good bytes.Buffer
+	err := gutrees.RenderMulti(div, &good, failingWriter{err: boom})
+
+	if err == nil || !errors.Is(err, boom) {
+		t.Fatalf("\t%s\t Should propagate the underlying writer error, got %v", failed, err)
+	}
+	if !strings.Contains(err.Error(), "writer 1") {
+		t.Fatalf("\t%s\t Should name the failing writer's index, got %s", failed, err)
+	}
+	t.Logf("\t%s\t Should report which writer failed", success)
+}
@@ -0,0 +1,50 @@
+package gutrees_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestRenderWithNonceStampsScriptAndStyleOnly(t *testing.T) {
+	existing := elems.Script()
+	(&gutrees.Attribute{Name: "nonce", Value: "keep-me"}).Apply(existing)
+
+	tree := elems.Div(
+		elems.Script(),
+		elems.Style(),
+		elems.Paragraph(elems.Text("hi")),
+		existing,
+	)
+
+	w := httptest.NewRecorder()
+	if err := gutrees.RenderWithNonce(w, tree, "abc123"); err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	children := tree.Children()
+
+	script := children[0].(*gutrees.Element)
+	style := children[1].(*gutrees.Element)
+	paragraph := children[2].(*gutrees.Element)
+
+	if n, err := gutrees.GetAttr(script, "nonce"); err != nil || n.Value != "abc123" {
+		t.Fatalf("\t%s\t Should stamp the nonce on the script element", failed)
+	}
+	if n, err := gutrees.GetAttr(style, "nonce"); err != nil || n.Value != "abc123" {
+		t.Fatalf("\t%s\t Should stamp the nonce on the style element", failed)
+	}
+	t.Logf("\t%s\t Should stamp the nonce on script and style elements", success)
+
+	if _, err := gutrees.GetAttr(paragraph, "nonce"); err == nil {
+		t.Fatalf("\t%s\t Should not stamp the nonce on unrelated elements", failed)
+	}
+	t.Logf("\t%s\t Should not stamp the nonce on unrelated elements", success)
+
+	if n, _ := gutrees.GetAttr(existing, "nonce"); n.Value != "keep-me" {
+		t.Fatalf("\t%s\t Should not overwrite an explicitly set nonce, got %q", failed, n.Value)
+	}
+	t.Logf("\t%s\t Should not overwrite an explicitly set nonce", success)
+}
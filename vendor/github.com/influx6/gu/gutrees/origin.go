@@ -0,0 +1,52 @@
+package gutrees
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// OriginTracking enables capturing the file:line of each NewElement call
+// site, for StampOrigins to surface later as data-gu-origin attributes
+// when debugging generated markup. It's a package-level switch rather
+// than a NewElement parameter so the many existing call sites (every
+// elems/svgelems/tables/forms constructor) don't need touching - flip it
+// on before building a tree, then call StampOrigins on the result. Off
+// by default, it costs nothing: NewElement skips runtime.Caller entirely.
+//
+// Since NewElement is usually called by a helper like elems.Div rather
+// than application code directly, the captured origin is that helper's
+// source line, not the application call site above it - still enough to
+// tell which constructor produced a given tag, but not a full call stack.
+var OriginTracking bool
+
+// originAttr is the attribute StampOrigins writes the captured origin to.
+const originAttr = "data-gu-origin"
+
+// captureOrigin returns the file:line of NewElement's caller, or "" if
+// OriginTracking is off. skip is the number of stack frames above
+// captureOrigin itself to unwind before reading the caller.
+func captureOrigin(skip int) string {
+	if !OriginTracking {
+		return ""
+	}
+
+	_, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// StampOrigins walks root and sets a data-gu-origin attribute on every
+// element whose origin was captured, for inspecting generated markup in
+// a browser's devtools. Elements built while OriginTracking was off have
+// no origin and are left untouched.
+func StampOrigins(root *Element) {
+	Walk(root, func(e *Element) bool {
+		if e.origin != "" {
+			(&Attribute{Name: originAttr, Value: e.origin}).Apply(e)
+		}
+		return true
+	})
+}
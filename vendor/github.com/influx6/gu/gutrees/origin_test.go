@@ -0,0 +1,35 @@
+package gutrees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestStampOriginsAddsNoAttributeWhenTrackingDisabled(t *testing.T) {
+	div := gutrees.NewElement("div", false)
+	gutrees.StampOrigins(div)
+
+	if _, err := gutrees.GetAttr(div, "data-gu-origin"); err == nil {
+		t.Fatalf("\t%s\t Should not stamp an origin when tracking is disabled", failed)
+	}
+	t.Logf("\t%s\t Should leave elements unstamped with tracking off", success)
+}
+
+func TestStampOriginsAddsAttributeWhenTrackingEnabled(t *testing.T) {
+	gutrees.OriginTracking = true
+	defer func() { gutrees.OriginTracking = false }()
+
+	div := gutrees.NewElement("div", false)
+	gutrees.StampOrigins(div)
+
+	attr, err := gutrees.GetAttr(div, "data-gu-origin")
+	if err != nil {
+		t.Fatalf("\t%s\t Should stamp an origin when tracking is enabled, got err %s", failed, err)
+	}
+	if !strings.Contains(attr.Value, "origin_test.go:") {
+		t.Fatalf("\t%s\t Should capture this file as the call site, got %q", failed, attr.Value)
+	}
+	t.Logf("\t%s\t Should stamp the NewElement call site when tracking is enabled", success)
+}
@@ -0,0 +1,134 @@
+package gutrees
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-humble/detect"
+)
+
+// RenderParallel renders e the same way RenderBytes does, but fans e's
+// direct children out across up to workers goroutines - one subtree per
+// goroutine - and concatenates their rendered output back together in
+// original order. Only independent subtrees can safely render
+// concurrently, so by default fan-out happens once, at e's immediate
+// children; use RenderParallelAt to push that boundary deeper for a tree
+// whose first level or two doesn't have enough siblings to keep workers
+// busy. workers <= 1 renders sequentially.
+//
+// The tree must not be mutated while RenderParallel runs: nothing here
+// synchronizes against a concurrent Apply/AddChild/RemoveAttr on the same
+// nodes, so a caller sharing a tree across goroutines is responsible for
+// treating it as read-only for the duration of the render.
+func RenderParallel(e *Element, workers int) string {
+	return RenderParallelAt(e, workers, 1)
+}
+
+// RenderParallelAt behaves like RenderParallel, but fans out subtrees
+// rooted maxDepth levels below e instead of stopping at e's direct
+// children. maxDepth <= 0 is treated as 1.
+func RenderParallelAt(e *Element, workers, maxDepth int) string {
+	if e == nil {
+		return ""
+	}
+
+	if workers <= 1 {
+		return string(RenderBytes(e))
+	}
+
+	if maxDepth <= 0 {
+		maxDepth = 1
+	}
+
+	sem := make(chan struct{}, workers)
+	return renderNodeParallel(e, maxDepth, sem)
+}
+
+// renderNodeParallel renders e using the same layout as ElementWriter.Print,
+// fanning e's children out across goroutines (bounded by sem) while
+// remainingDepth is still positive. A Removed() node renders as nothing
+// on the server. A "lazy", "custom-render" or "conditional-comment" node
+// is delegated straight to SimpleElementWriter.Print instead of being
+// fanned out itself - these are special cases Print resolves on its own
+// terms (calling a lazyFn or a CustomRenderer), not plain tag
+// serialization, so re-deriving them here would just be a second place
+// for that logic to drift out of sync with Print's. Print renders such a
+// node's whole subtree sequentially; fan-out applies only above it, via
+// the renderChildrenParallel call that reached it.
+func renderNodeParallel(e *Element, remainingDepth int, sem chan struct{}) string {
+	if detect.IsServer() && e.Removed() {
+		return ""
+	}
+
+	if e.Name() == "text" {
+		return SimpleTextWriter.Print(e)
+	}
+
+	switch e.Name() {
+	case "lazy", "custom-render", "conditional-comment":
+		return SimpleElementWriter.Print(e)
+	}
+
+	if e.Name() == "fragment" {
+		return renderChildrenParallel(e, remainingDepth, sem)
+	}
+
+	hash := &Attribute{"hash", e.Hash()}
+	uid := &Attribute{"uid", e.UID()}
+	hashes := SimpleAttrWriter.Print([]*Attribute{hash, uid})
+	attrs := SimpleAttrWriter.Print(e.Attributes())
+
+	var styleAttr string
+	if style := SimpleStyleWriter.Print(e.Styles()); style != "" {
+		styleAttr = fmt.Sprintf(` style="%s"`, style)
+	}
+
+	if e.AutoClosed() {
+		return strings.Join([]string{"<" + e.Name(), hashes, attrs, styleAttr, "/>"}, "")
+	}
+
+	return strings.Join([]string{
+		"<" + e.Name(), hashes, attrs, styleAttr, ">",
+		e.textContent,
+		renderChildrenParallel(e, remainingDepth, sem),
+		"</" + e.Name() + ">",
+	}, "")
+}
+
+// renderChildrenParallel renders e's element children, fanning them out
+// across goroutines while remainingDepth is still positive and there's
+// more than one child to split across, falling back to a plain
+// sequential loop otherwise (at depth 0, or for a lone child there's
+// nothing to gain from a goroutine).
+func renderChildrenParallel(e *Element, remainingDepth int, sem chan struct{}) string {
+	var children []*Element
+	for _, ch := range e.Children() {
+		if ech, ok := ch.(*Element); ok && ech != e {
+			children = append(children, ech)
+		}
+	}
+
+	parts := make([]string, len(children))
+
+	if remainingDepth <= 0 || len(children) <= 1 {
+		for i, ch := range children {
+			parts[i] = renderNodeParallel(ch, remainingDepth-1, sem)
+		}
+		return strings.Join(parts, "")
+	}
+
+	var wg sync.WaitGroup
+	for i, ch := range children {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, ch *Element) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			parts[i] = renderNodeParallel(ch, remainingDepth-1, sem)
+		}(i, ch)
+	}
+	wg.Wait()
+
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,116 @@
+package gutrees_test
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// wideTree builds a <div> with n <p> children, each holding its own text,
+// so fan-out has plenty of independent subtrees to split across workers.
+func wideTree(n int) *gutrees.Element {
+	children := make([]gutrees.Appliable, n)
+	for i := 0; i < n; i++ {
+		children[i] = elems.Paragraph(elems.Text("item " + strconv.Itoa(i)))
+	}
+	return elems.Div(children...)
+}
+
+func TestRenderParallelMatchesSequentialOutput(t *testing.T) {
+	tree := wideTree(20)
+
+	want := string(gutrees.RenderBytes(tree))
+	got := gutrees.RenderParallel(tree, 4)
+
+	if got != want {
+		t.Fatalf("\t%s\t Should render the same output as RenderBytes, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should match the sequential renderer's output", success)
+}
+
+func TestRenderParallelSingleWorkerMatchesSequential(t *testing.T) {
+	tree := wideTree(5)
+
+	want := string(gutrees.RenderBytes(tree))
+	got := gutrees.RenderParallel(tree, 1)
+
+	if got != want {
+		t.Fatalf("\t%s\t Should render sequentially when workers <= 1, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should fall back to sequential rendering for workers <= 1", success)
+}
+
+func TestRenderParallelAtDeeperDepthMatchesSequential(t *testing.T) {
+	tree := elems.Div(
+		elems.Section(elems.Paragraph(elems.Text("a")), elems.Paragraph(elems.Text("b"))),
+		elems.Section(elems.Paragraph(elems.Text("c")), elems.Paragraph(elems.Text("d"))),
+	)
+
+	want := string(gutrees.RenderBytes(tree))
+	got := gutrees.RenderParallelAt(tree, 4, 2)
+
+	if got != want {
+		t.Fatalf("\t%s\t Should match sequential output when fanning out two levels deep, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should match sequential output at a configurable fan-out depth", success)
+}
+
+func TestRenderParallelSkipsRemovedChild(t *testing.T) {
+	removed := elems.Paragraph(elems.Text("gone"))
+	removed.Remove()
+	tree := elems.Div(removed, elems.Paragraph(elems.Text("kept")))
+
+	want := string(gutrees.RenderBytes(tree))
+	got := gutrees.RenderParallel(tree, 4)
+
+	if got != want {
+		t.Fatalf("\t%s\t Should match RenderBytes for a tree with a removed child, got %q want %q", failed, got, want)
+	}
+	if strings.Contains(got, "gone") {
+		t.Fatalf("\t%s\t Should skip the removed child's content, got %q", failed, got)
+	}
+	t.Logf("\t%s\t Should skip a removed child the same way RenderBytes does", success)
+}
+
+func TestRenderParallelMatchesSequentialForLazyAndCustomRender(t *testing.T) {
+	tree := elems.Div(
+		gutrees.Lazy(func() *gutrees.Element { return elems.Paragraph(elems.Text("lazy")) }),
+		gutrees.CustomRender(customRendererFunc(func(w io.Writer) error {
+			_, err := io.WriteString(w, "<custom/>")
+			return err
+		})),
+		elems.Paragraph(elems.Text("plain")),
+	)
+
+	want := string(gutrees.RenderBytes(tree))
+	got := gutrees.RenderParallel(tree, 4)
+
+	if got != want {
+		t.Fatalf("\t%s\t Should match RenderBytes for a tree with lazy/custom-render children, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should render lazy and custom-render nodes the same way RenderBytes does", success)
+}
+
+type customRendererFunc func(w io.Writer) error
+
+func (f customRendererFunc) RenderSelf(w io.Writer) error { return f(w) }
+
+func BenchmarkRenderSequentialWideTree(b *testing.B) {
+	tree := wideTree(200)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		gutrees.RenderBytes(tree)
+	}
+}
+
+func BenchmarkRenderParallelWideTree(b *testing.B) {
+	tree := wideTree(200)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		gutrees.RenderParallel(tree, 8)
+	}
+}
@@ -0,0 +1,110 @@
+package gutrees
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// voidElements lists the HTML void/self-closing tags that never receive a
+// matching close tag, mirroring the standard HTML5 void element list.
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"param": true, "source": true, "track": true, "wbr": true,
+}
+
+// Parse converts an HTML fragment into a gutrees tree rooted in a fragment
+// element, using golang.org/x/net/html's tokenizer and silently applying
+// the same auto-corrections a browser would for malformed markup. Use
+// ParseStrict to learn about those corrections instead of silently
+// accepting them.
+func Parse(source string) (*Element, error) {
+	e, _, err := parse(source)
+	return e, err
+}
+
+// Warning describes a single auto-correction ParseStrict made while
+// parsing malformed markup: an implicitly closed tag, or a stray close tag
+// with nothing open to match. No other kind of correction (such as content
+// the tokenizer drops) is reported.
+type Warning struct {
+	Tag         string
+	Description string
+}
+
+// ParseStrict parses source like Parse, additionally returning a Warning
+// for every tag the tokenizer had to implicitly close (e.g. `<p><div></p>`
+// closes the still-open <div> when </p> arrives) or that had no matching
+// open tag at all.
+func ParseStrict(source string) (*Element, []Warning, error) {
+	return parse(source)
+}
+
+func parse(source string) (*Element, []Warning, error) {
+	tokenizer := html.NewTokenizer(strings.NewReader(source))
+
+	root := NewFragment()
+	stack := []*Element{root}
+	var warnings []Warning
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if err := tokenizer.Err(); err != nil && err.Error() != "EOF" {
+				return root, warnings, err
+			}
+			return root, warnings, nil
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := tokenizer.Token()
+
+			selfClosing := tok.Type == html.SelfClosingTagToken || voidElements[tok.Data]
+			el := NewElement(tok.Data, selfClosing)
+			for _, a := range tok.Attr {
+				(&Attribute{Name: a.Key, Value: a.Val}).Apply(el)
+			}
+			el.Apply(stack[len(stack)-1])
+
+			if !selfClosing {
+				stack = append(stack, el)
+			}
+
+		case html.TextToken:
+			text := tokenizer.Token().Data
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			NewText(text).Apply(stack[len(stack)-1])
+
+		case html.EndTagToken:
+			tok := tokenizer.Token()
+
+			matched := -1
+			for i := len(stack) - 1; i >= 1; i-- {
+				if stack[i].Name() == tok.Data {
+					matched = i
+					break
+				}
+			}
+
+			if matched == -1 {
+				warnings = append(warnings, Warning{
+					Tag:         tok.Data,
+					Description: fmt.Sprintf("closing tag </%s> has no matching open tag", tok.Data),
+				})
+				continue
+			}
+
+			for i := len(stack) - 1; i > matched; i-- {
+				warnings = append(warnings, Warning{
+					Tag:         stack[i].Name(),
+					Description: fmt.Sprintf("<%s> was implicitly closed by </%s>", stack[i].Name(), tok.Data),
+				})
+			}
+
+			stack = stack[:matched]
+		}
+	}
+}
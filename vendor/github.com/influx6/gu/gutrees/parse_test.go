@@ -0,0 +1,63 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestParseStrictWarnsOnImplicitClose(t *testing.T) {
+	_, warnings, err := gutrees.ParseStrict("<p><div></p>")
+	if err != nil {
+		t.Fatalf("\t%s\t Should parse without error, got %s", failed, err)
+	}
+
+	if len(warnings) == 0 {
+		t.Fatalf("\t%s\t Should report a warning for the implicitly closed <div>", failed)
+	}
+
+	found := false
+	for _, w := range warnings {
+		if w.Tag == "div" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("\t%s\t Should name div as the offending tag, got %+v", failed, warnings)
+	}
+	t.Logf("\t%s\t Should warn when a tag is implicitly closed by a mismatched close tag", success)
+}
+
+func TestParseStrictNoWarningsForWellFormedMarkup(t *testing.T) {
+	_, warnings, err := gutrees.ParseStrict("<div><p>hello</p></div>")
+	if err != nil {
+		t.Fatalf("\t%s\t Should parse without error, got %s", failed, err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("\t%s\t Should not warn on well-formed markup, got %+v", failed, warnings)
+	}
+	t.Logf("\t%s\t Should not warn on well-formed markup", success)
+}
+
+func TestParseBuildsTree(t *testing.T) {
+	root, err := gutrees.Parse("<div class=\"card\">hi</div>")
+	if err != nil {
+		t.Fatalf("\t%s\t Should parse without error, got %s", failed, err)
+	}
+
+	children := root.Children()
+	if len(children) != 1 {
+		t.Fatalf("\t%s\t Should produce one top-level element, got %d", failed, len(children))
+	}
+
+	div := children[0].(*gutrees.Element)
+	if div.Name() != "div" {
+		t.Fatalf("\t%s\t Should parse the div tag, got %q", failed, div.Name())
+	}
+
+	class, err := gutrees.GetAttr(div, "class")
+	if err != nil || class.Value != "card" {
+		t.Fatalf("\t%s\t Should carry the div's attributes", failed)
+	}
+	t.Logf("\t%s\t Should build a gutrees tree from well-formed markup", success)
+}
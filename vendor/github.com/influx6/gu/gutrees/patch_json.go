@@ -0,0 +1,57 @@
+package gutrees
+
+import "encoding/json"
+
+// patchEnvelopeVersion is bumped whenever the JSON shape written by
+// MarshalPatches changes in a way a consumer needs to branch on.
+const patchEnvelopeVersion = 1
+
+// patchEnvelope is the stable, versioned JSON shape MarshalPatches writes
+// and UnmarshalPatches reads back.
+type patchEnvelope struct {
+	Version int           `json:"version"`
+	Patches []patchWireV1 `json:"patches"`
+}
+
+// patchWireV1 is the over-the-wire representation of a Patch: field names
+// and casing a small JS runtime can consume directly, with Attrs/HTML
+// omitted when a patch doesn't use them.
+type patchWireV1 struct {
+	Op    PatchOp           `json:"op"`
+	Path  []int             `json:"path"`
+	Attrs map[string]string `json:"attributes,omitempty"`
+	HTML  string            `json:"html,omitempty"`
+}
+
+// MarshalPatches encodes patches as versioned JSON suitable for sending
+// to a thin client that applies them against its own copy of the tree.
+// Node paths are child-index arrays, as produced by Diff.
+func MarshalPatches(patches []Patch) ([]byte, error) {
+	wire := make([]patchWireV1, len(patches))
+	for i, p := range patches {
+		wire[i] = patchWireV1{Op: p.Op, Path: p.Path, Attrs: p.Attrs, HTML: p.HTML}
+	}
+
+	return json.Marshal(patchEnvelope{Version: patchEnvelopeVersion, Patches: wire})
+}
+
+// UnmarshalPatches decodes JSON produced by MarshalPatches back into
+// Patches, returning ErrUnsupportedPatchVersion if the envelope's version
+// is newer than this package understands.
+func UnmarshalPatches(data []byte) ([]Patch, error) {
+	var env patchEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+
+	if env.Version > patchEnvelopeVersion {
+		return nil, ErrUnsupportedPatchVersion
+	}
+
+	patches := make([]Patch, len(env.Patches))
+	for i, w := range env.Patches {
+		patches[i] = Patch{Op: w.Op, Path: w.Path, Attrs: w.Attrs, HTML: w.HTML}
+	}
+
+	return patches, nil
+}
@@ -0,0 +1,56 @@
+package gutrees_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestMarshalPatchesGoldenJSONForAttributeChange(t *testing.T) {
+	patches := []gutrees.Patch{
+		{Op: gutrees.PatchAttrs, Path: []int{0, 1}, Attrs: map[string]string{"class": "active"}},
+	}
+
+	out, err := gutrees.MarshalPatches(patches)
+	if err != nil {
+		t.Fatalf("\t%s\t Should marshal without error, got %s", failed, err)
+	}
+
+	want := `{"version":1,"patches":[{"op":"attrs","path":[0,1],"attributes":{"class":"active"}}]}`
+	if string(out) != want {
+		t.Fatalf("\t%s\t Should produce the expected golden JSON.\ngot:  %s\nwant: %s", failed, out, want)
+	}
+	t.Logf("\t%s\t Should produce stable, versioned JSON for an attribute-change patch", success)
+}
+
+func TestMarshalPatchesRoundTrips(t *testing.T) {
+	original := []gutrees.Patch{
+		{Op: gutrees.PatchAttrs, Path: []int{0}, Attrs: map[string]string{"id": "b"}},
+		{Op: gutrees.PatchInsert, Path: []int{1}, HTML: "<li></li>"},
+		{Op: gutrees.PatchRemove, Path: []int{2}},
+	}
+
+	data, err := gutrees.MarshalPatches(original)
+	if err != nil {
+		t.Fatalf("\t%s\t Should marshal without error, got %s", failed, err)
+	}
+
+	got, err := gutrees.UnmarshalPatches(data)
+	if err != nil {
+		t.Fatalf("\t%s\t Should unmarshal without error, got %s", failed, err)
+	}
+
+	if !reflect.DeepEqual(original, got) {
+		t.Fatalf("\t%s\t Should round-trip patches unchanged.\ngot:  %+v\nwant: %+v", failed, got, original)
+	}
+	t.Logf("\t%s\t Should round-trip a mix of patch kinds through Marshal/UnmarshalPatches", success)
+}
+
+func TestUnmarshalPatchesRejectsNewerVersion(t *testing.T) {
+	_, err := gutrees.UnmarshalPatches([]byte(`{"version":99,"patches":[]}`))
+	if err != gutrees.ErrUnsupportedPatchVersion {
+		t.Fatalf("\t%s\t Should reject a newer envelope version, got %v", failed, err)
+	}
+	t.Logf("\t%s\t Should reject a patch envelope newer than this package understands", success)
+}
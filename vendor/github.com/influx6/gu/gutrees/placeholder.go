@@ -0,0 +1,75 @@
+package gutrees
+
+import "fmt"
+
+// placeholderNameAttr is the attribute Placeholder stashes its name
+// under, read back by Fill/FillStrict to find the right value for a hole.
+const placeholderNameAttr = "data-gu-placeholder"
+
+// Placeholder returns a marker node standing in for a named hole in a
+// reusable tree, to be built once and filled in differently per use via
+// Fill or FillStrict.
+func Placeholder(name string) *Element {
+	e := NewElement("placeholder", false)
+	(&Attribute{Name: placeholderNameAttr, Value: name}).Apply(e)
+	return e
+}
+
+// Fill clones tree and replaces every Placeholder in it with the subtree
+// from values matching its name, leaving a placeholder with no matching
+// entry as an empty "placeholder" element rather than failing the whole
+// render - use FillStrict where a missing value should be caught instead.
+func Fill(tree *Element, values map[string]*Element) *Element {
+	cloned := tree.Clone().(*Element)
+	fillPlaceholders(cloned, values, nil)
+	return cloned
+}
+
+// FillStrict behaves like Fill, but returns an error naming the first
+// placeholder with no matching entry in values instead of rendering it
+// empty.
+func FillStrict(tree *Element, values map[string]*Element) (*Element, error) {
+	cloned := tree.Clone().(*Element)
+
+	var missing error
+	fillPlaceholders(cloned, values, &missing)
+	if missing != nil {
+		return nil, missing
+	}
+
+	return cloned, nil
+}
+
+// fillPlaceholders walks e's children in place, substituting a clone of
+// values[name] for each Placeholder found. missing, if non-nil, is set to
+// the first error encountered for a name with no entry in values.
+func fillPlaceholders(e *Element, values map[string]*Element, missing *error) {
+	for i, ch := range e.children {
+		ech, ok := ch.(*Element)
+		if !ok {
+			continue
+		}
+
+		if ech.Name() != "placeholder" {
+			fillPlaceholders(ech, values, missing)
+			continue
+		}
+
+		name, err := GetAttr(ech, placeholderNameAttr)
+		if err != nil {
+			continue
+		}
+
+		fill, ok := values[name.Value]
+		if !ok {
+			if missing != nil && *missing == nil {
+				*missing = fmt.Errorf("gutrees: no fill provided for placeholder %q", name.Value)
+			}
+			continue
+		}
+
+		clone := fill.Clone().(*Element)
+		clone.parent = e
+		e.children[i] = clone
+	}
+}
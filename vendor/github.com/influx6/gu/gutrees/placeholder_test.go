@@ -0,0 +1,69 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func buildTemplateWithTwoHoles() *gutrees.Element {
+	root := gutrees.NewElement("div", false)
+	gutrees.Placeholder("header").Apply(root)
+	gutrees.Placeholder("body").Apply(root)
+	return root
+}
+
+func TestFillReplacesTwoPlaceholders(t *testing.T) {
+	tmpl := buildTemplateWithTwoHoles()
+
+	header := gutrees.NewElement("h1", false)
+	gutrees.NewText("Title").Apply(header)
+
+	body := gutrees.NewElement("p", false)
+	gutrees.NewText("Content").Apply(body)
+
+	filled := gutrees.Fill(tmpl, map[string]*gutrees.Element{
+		"header": header,
+		"body":   body,
+	})
+
+	children := filled.Children()
+	if len(children) != 2 {
+		t.Fatalf("\t%s\t Should keep both slots, got %d children", failed, len(children))
+	}
+
+	first := children[0].(*gutrees.Element)
+	second := children[1].(*gutrees.Element)
+	if first.Name() != "h1" || second.Name() != "p" {
+		t.Fatalf("\t%s\t Should replace each placeholder with its fill, got %q and %q", failed, first.Name(), second.Name())
+	}
+	t.Logf("\t%s\t Should fill both named placeholders", success)
+
+	if len(tmpl.Children()) != 2 || tmpl.Children()[0].(*gutrees.Element).Name() != "placeholder" {
+		t.Fatalf("\t%s\t Should leave the original template untouched", failed)
+	}
+	t.Logf("\t%s\t Should clone the template rather than mutating it", success)
+}
+
+func TestFillRendersEmptyForMissingValue(t *testing.T) {
+	tmpl := gutrees.NewElement("div", false)
+	gutrees.Placeholder("missing").Apply(tmpl)
+
+	filled := gutrees.Fill(tmpl, map[string]*gutrees.Element{})
+
+	child := filled.Children()[0].(*gutrees.Element)
+	if child.Name() != "placeholder" {
+		t.Fatalf("\t%s\t Should leave an unfilled placeholder as-is, got %q", failed, child.Name())
+	}
+	t.Logf("\t%s\t Should leave a placeholder with no fill alone", success)
+}
+
+func TestFillStrictErrorsOnMissingValue(t *testing.T) {
+	tmpl := gutrees.NewElement("div", false)
+	gutrees.Placeholder("missing").Apply(tmpl)
+
+	if _, err := gutrees.FillStrict(tmpl, map[string]*gutrees.Element{}); err == nil {
+		t.Fatalf("\t%s\t Should error when a placeholder has no matching fill", failed)
+	}
+	t.Logf("\t%s\t Should error strictly on a missing fill", success)
+}
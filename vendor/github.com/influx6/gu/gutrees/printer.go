@@ -1,8 +1,12 @@
 package gutrees
 
 import (
+	"bytes"
 	"fmt"
+	"html"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/go-humble/detect"
 )
@@ -14,13 +18,47 @@ type AttrPrinter interface {
 	Print([]*Attribute) string
 }
 
+// QuoteStyle controls how attribute values are quoted when rendered.
+type QuoteStyle int
+
+// Available QuoteStyle modes.
+const (
+	// QuoteDouble wraps every value in double quotes: name="value". This
+	// is the default, matching existing behavior.
+	QuoteDouble QuoteStyle = iota
+
+	// QuoteSingle wraps every value in single quotes: name='value'.
+	QuoteSingle
+
+	// QuoteMinimal omits quotes for non-empty values containing none of
+	// whitespace, quotes (including '`') or '<'/'>'/'=', falling back to
+	// double quotes otherwise.
+	QuoteMinimal
+)
+
+// needsQuoting reports whether val must be quoted regardless of
+// QuoteStyle, because rendering it bare would be ambiguous or unsafe.
+func needsQuoting(val string) bool {
+	return val == "" || strings.ContainsAny(val, " \t\n\r\"'=<>`")
+}
+
 // AttrWriter provides a concrete struct that meets the AttrPrinter interface
-type AttrWriter struct{}
+type AttrWriter struct {
+	quoteStyle QuoteStyle
+}
 
 // SimpleAttrWriter provides a basic attribute writer
 var SimpleAttrWriter = &AttrWriter{}
 
 const attrformt = ` %s="%s"`
+const attrformtSingle = ` %s='%s'`
+const attrformtMinimal = ` %s=%s`
+
+// SetQuoteStyle controls how this writer quotes attribute values. The
+// default, QuoteDouble, matches existing behavior.
+func (m *AttrWriter) SetQuoteStyle(s QuoteStyle) {
+	m.quoteStyle = s
+}
 
 // Print returns a stringed repesentation of the attribute object
 func (m *AttrWriter) Print(a []*Attribute) string {
@@ -31,12 +69,34 @@ func (m *AttrWriter) Print(a []*Attribute) string {
 	attrs := []string{}
 
 	for _, ar := range a {
-		attrs = append(attrs, fmt.Sprintf(attrformt, ar.Name, ar.Value))
+		attrs = append(attrs, m.printOne(ar))
 	}
 
 	return strings.Join(attrs, " ")
 }
 
+// printOne formats a single attribute according to the writer's
+// QuoteStyle, always falling back to double quotes for values that can't
+// safely go unquoted or in single quotes.
+func (m *AttrWriter) printOne(ar *Attribute) string {
+	switch m.quoteStyle {
+	case QuoteSingle:
+		if strings.Contains(ar.Value, "'") {
+			return fmt.Sprintf(attrformt, ar.Name, ar.Value)
+		}
+		return fmt.Sprintf(attrformtSingle, ar.Name, ar.Value)
+
+	case QuoteMinimal:
+		if needsQuoting(ar.Value) {
+			return fmt.Sprintf(attrformt, ar.Name, ar.Value)
+		}
+		return fmt.Sprintf(attrformtMinimal, ar.Name, ar.Value)
+
+	default:
+		return fmt.Sprintf(attrformt, ar.Name, ar.Value)
+	}
+}
+
 // StylePrinter defines a printer interface for writing out a style objects into a string form
 type StylePrinter interface {
 	Print([]*Style) string
@@ -70,19 +130,98 @@ type TextPrinter interface {
 	Print(Markup) string
 }
 
+// TextEscaper escapes a text node's content before it's written out.
+// Different contexts want different rules - e.g. content that's already
+// percent- or entity-encoded shouldn't be escaped again - so it's
+// pluggable via TextWriter.SetEscaper rather than fixed.
+type TextEscaper func(string) string
+
+// EscapeHTML is the default TextEscaper. It escapes the characters HTML
+// parsing treats specially (& < > " '), via html.EscapeString, so text
+// content can't be mistaken for markup.
+func EscapeHTML(s string) string {
+	return html.EscapeString(s)
+}
+
 // TextWriter writes out the text element/node for the vdom into a string
-type TextWriter struct{}
+type TextWriter struct {
+	escape TextEscaper
+}
 
 // SimpleTextWriter provides a basic text writer
-var SimpleTextWriter = &TextWriter{}
+var SimpleTextWriter = &TextWriter{escape: EscapeHTML}
+
+// SetEscaper controls how this writer escapes text node content. The
+// default, EscapeHTML, matches existing behavior. Passing nil restores
+// it. Text nested directly under a <script> or <style> element is never
+// escaped, regardless of policy, since that content isn't parsed as
+// HTML text in the first place.
+func (m *TextWriter) SetEscaper(fn TextEscaper) {
+	if fn == nil {
+		fn = EscapeHTML
+	}
+	m.escape = fn
+}
 
 // Print returns the string representation of the text object
 func (m *TextWriter) Print(t Markup) string {
-	if tt, ok := t.(TextMarkup); ok {
-		return tt.TextContent()
+	tt, ok := t.(TextMarkup)
+	if !ok {
+		return ""
+	}
+
+	content := tt.TextContent()
+
+	if e, ok := t.(*Element); ok && inRawTextContext(e) {
+		return content
+	}
+
+	escape := m.escape
+	if escape == nil {
+		escape = EscapeHTML
 	}
 
-	return ""
+	return escape(content)
+}
+
+// inRawTextContext reports whether e sits directly under a <script> or
+// <style> element, whose content browsers never parse as HTML text, so
+// it must reach output untouched by any escaping policy.
+func inRawTextContext(e *Element) bool {
+	p := e.Parent()
+	return p != nil && (p.Name() == "script" || p.Name() == "style")
+}
+
+// VoidStyle controls how void (self-closing) elements like <br> or <img>
+// are serialized.
+type VoidStyle int
+
+// Available VoidStyle modes.
+const (
+	// VoidSelfClosing renders void elements as "<br/>". This is the
+	// default, matching existing behavior.
+	VoidSelfClosing VoidStyle = iota
+
+	// VoidSelfClosingSpaced renders void elements as "<br />", the
+	// conventional XHTML style.
+	VoidSelfClosingSpaced
+
+	// VoidHTML5 renders void elements as "<br>", with no trailing slash.
+	VoidHTML5
+)
+
+// RenderHooks lets a caller observe per-element render timing, e.g. to
+// aggregate how expensive each tag is to render. Either field may be
+// nil; a nil hook costs only the nil check, so this is cheap enough to
+// leave wired up in production and only populate while profiling.
+type RenderHooks struct {
+	// OnEnter is called right before an element's subtree starts
+	// rendering.
+	OnEnter func(*Element)
+
+	// OnExit is called right after an element's subtree finishes
+	// rendering, with how long that subtree took to render.
+	OnExit func(e *Element, took time.Duration)
 }
 
 // ElementWriter writes out the element out as a string matching the html tag rules
@@ -91,6 +230,10 @@ type ElementWriter struct {
 	styleWriter  StylePrinter
 	text         TextPrinter
 	allowRemoved bool
+	voidStyle    VoidStyle
+	voidTags     map[string]bool
+	maxDepth     int
+	hooks        RenderHooks
 }
 
 // SimpleElementWriter provides a default writer using the basic attribute and style writers
@@ -117,6 +260,59 @@ func (m *ElementWriter) AllowRemoved() {
 	m.allowRemoved = true
 }
 
+// SetVoidStyle controls how this writer serializes void/self-closing
+// elements. The default, VoidSelfClosing, matches existing behavior.
+func (m *ElementWriter) SetVoidStyle(s VoidStyle) {
+	m.voidStyle = s
+}
+
+// SetVoidTags overrides, per tag name, whether this writer treats an
+// element as void (self-closing, no children or closing tag) regardless
+// of the autoclose flag it was constructed with - for a templating
+// dialect with its own void-element set, e.g. forcing a custom tag to
+// render self-closing, or an XML profile where nothing is void. A tag
+// name absent from tags falls back to the element's own AutoClosed().
+// Passing nil restores that default behavior entirely.
+func (m *ElementWriter) SetVoidTags(tags map[string]bool) {
+	m.voidTags = tags
+}
+
+// isVoid reports whether e should render self-closing, checking this
+// writer's SetVoidTags override before falling back to e.AutoClosed().
+func (m *ElementWriter) isVoid(e *Element) bool {
+	if v, ok := m.voidTags[e.Name()]; ok {
+		return v
+	}
+	return e.AutoClosed()
+}
+
+// SetMaxDepth caps how deep the streaming render path (RenderStreaming,
+// RenderInto, RenderBytes) will recurse into a tree before giving up with
+// ErrMaxDepthExceeded, guarding against a runaway or self-referential
+// tree. n <= 0 restores the default, DefaultMaxDepth. Print, the
+// non-streaming writer, is unaffected - it predates this guard and has no
+// error return to report the failure through.
+func (m *ElementWriter) SetMaxDepth(n int) {
+	m.maxDepth = n
+}
+
+// SetHooks installs hooks that observe per-element render timing on the
+// streaming render path (RenderStreaming, RenderInto, RenderBytes).
+// This is diagnostics only - it never changes rendered output - and a
+// zero-value RenderHooks (the default) disables it entirely.
+func (m *ElementWriter) SetHooks(h RenderHooks) {
+	m.hooks = h
+}
+
+// depthLimit returns the effective max depth for this writer, falling
+// back to DefaultMaxDepth when SetMaxDepth hasn't been called.
+func (m *ElementWriter) depthLimit() int {
+	if m.maxDepth <= 0 {
+		return DefaultMaxDepth
+	}
+	return m.maxDepth
+}
+
 /* ----------------code within this region is usually for testing purposes----------->>>*/
 
 // Print returns the string representation of the element
@@ -133,6 +329,56 @@ func (m *ElementWriter) Print(e *Element) string {
 		return m.text.Print(e)
 	}
 
+	//a lazy placeholder resolves to its built subtree the first time it's
+	//actually rendered, rather than building it up front
+	if e.Name() == "lazy" {
+		if resolved := e.resolveLazy(); resolved != nil {
+			return m.Print(resolved)
+		}
+		return ""
+	}
+
+	//a custom-render node delegates entirely to its CustomRenderer instead
+	//of the usual tag serialization
+	if e.Name() == "custom-render" {
+		if e.customRenderer == nil {
+			return ""
+		}
+		var buf bytes.Buffer
+		if err := e.customRenderer.RenderSelf(&buf); err != nil {
+			log.Printf("gutrees: custom renderer error: %v", err)
+			return ""
+		}
+		return buf.String()
+	}
+
+	//a conditional comment wraps its rendered children in downlevel-revealed
+	//IE conditional comment syntax instead of a tag of its own
+	if e.Name() == "conditional-comment" {
+		var condition string
+		if attr, err := GetAttr(e, "data-condition"); err == nil {
+			condition = attr.Value
+		}
+		var children = []string{}
+		for _, ch := range e.Children() {
+			if ech, ok := ch.(*Element); ok {
+				children = append(children, m.Print(ech))
+			}
+		}
+		return fmt.Sprintf("<!--[if %s]>%s<![endif]-->", condition, strings.Join(children, ""))
+	}
+
+	//fragments contribute no wrapping tag of their own, just their children
+	if e.Name() == "fragment" {
+		var children = []string{}
+		for _, ch := range e.Children() {
+			if ech, ok := ch.(*Element); ok {
+				children = append(children, m.Print(ech))
+			}
+		}
+		return strings.Join(children, "")
+	}
+
 	//collect uid and hash of the element so we can write them along
 	hash := &Attribute{"hash", e.Hash()}
 	uid := &Attribute{"uid", e.UID()}
@@ -153,11 +399,25 @@ func (m *ElementWriter) Print(e *Element) string {
 	//write out the elements inline-styles using the StyleWriter
 	style := m.styleWriter.Print(e.Styles())
 
+	//only emit the style attribute when there is actually something to write,
+	//so removing the last style property drops it instead of leaving style=""
+	var styleAttr string
+	if style != "" {
+		styleAttr = fmt.Sprintf(` style="%s"`, style)
+	}
+
 	var closer string
 	var beginbrack string
 
-	if e.AutoClosed() {
-		closer = "/>"
+	if m.isVoid(e) {
+		switch m.voidStyle {
+		case VoidSelfClosingSpaced:
+			closer = " />"
+		case VoidHTML5:
+			closer = ">"
+		default:
+			closer = "/>"
+		}
 	} else {
 		beginbrack = ">"
 		closer = fmt.Sprintf("</%s>", e.Name())
@@ -183,7 +443,7 @@ func (m *ElementWriter) Print(e *Element) string {
 		fmt.Sprintf("<%s", e.Name()),
 		hashes,
 		attrs,
-		fmt.Sprintf(` style="%s"`, style),
+		styleAttr,
 		beginbrack,
 		e.textContent,
 		strings.Join(children, ""),
@@ -0,0 +1,94 @@
+package gutrees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestVoidStyleRendering(t *testing.T) {
+	img := elems.Input()
+
+	defaultOut, err := gutrees.SimpleMarkupWriter.Write(img)
+	if err != nil {
+		t.Fatalf("\t%s\t Should write without error, got %s", failed, err)
+	}
+	if !strings.Contains(defaultOut, "/>") || strings.Contains(defaultOut, " />") {
+		t.Fatalf("\t%s\t Should default to unspaced self-closing, got %s", failed, defaultOut)
+	}
+	t.Logf("\t%s\t Should default to the existing unspaced self-closing style", success)
+
+	html5Writer := gutrees.NewElementWriter(gutrees.SimpleAttrWriter, gutrees.SimpleStyleWriter, gutrees.SimpleTextWriter)
+	html5Writer.SetVoidStyle(gutrees.VoidHTML5)
+
+	html5Out := html5Writer.Print(img)
+	if strings.Contains(html5Out, "/>") {
+		t.Fatalf("\t%s\t Should render void elements without a trailing slash in HTML5 mode, got %s", failed, html5Out)
+	}
+	t.Logf("\t%s\t Should render <input> without a trailing slash in HTML5 mode", success)
+
+	spacedWriter := gutrees.NewElementWriter(gutrees.SimpleAttrWriter, gutrees.SimpleStyleWriter, gutrees.SimpleTextWriter)
+	spacedWriter.SetVoidStyle(gutrees.VoidSelfClosingSpaced)
+
+	spacedOut := spacedWriter.Print(img)
+	if !strings.Contains(spacedOut, " />") {
+		t.Fatalf("\t%s\t Should render void elements with a spaced self-close in XHTML spaced mode, got %s", failed, spacedOut)
+	}
+	t.Logf("\t%s\t Should render <input> with a spaced self-close in XHTML spaced mode", success)
+}
+
+func TestSetVoidTagsForcesNonVoidTagSelfClosing(t *testing.T) {
+	custom := gutrees.NewElement("my-widget", false)
+
+	writer := gutrees.NewElementWriter(gutrees.SimpleAttrWriter, gutrees.SimpleStyleWriter, gutrees.SimpleTextWriter)
+	writer.SetVoidTags(map[string]bool{"my-widget": true})
+
+	out := writer.Print(custom)
+	if !strings.Contains(out, "/>") {
+		t.Fatalf("\t%s\t Should render the overridden tag self-closing, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should force a normally-non-void tag to render self-closing", success)
+}
+
+func TestSetVoidTagsFallsBackToAutoClosedForUnlistedTag(t *testing.T) {
+	div := gutrees.NewElement("div", false)
+
+	writer := gutrees.NewElementWriter(gutrees.SimpleAttrWriter, gutrees.SimpleStyleWriter, gutrees.SimpleTextWriter)
+	writer.SetVoidTags(map[string]bool{"my-widget": true})
+
+	out := writer.Print(div)
+	if strings.Contains(out, "/>") {
+		t.Fatalf("\t%s\t Should leave an unlisted tag's own autoclose behavior alone, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should fall back to AutoClosed for a tag not in the override map", success)
+}
+
+func TestQuoteStyleMinimalQuotesOnlyWhenNeeded(t *testing.T) {
+	minimal := &gutrees.AttrWriter{}
+	minimal.SetQuoteStyle(gutrees.QuoteMinimal)
+
+	safe := minimal.Print([]*gutrees.Attribute{{Name: "class", Value: "card"}})
+	if safe != " class=card" {
+		t.Fatalf("\t%s\t Should omit quotes for a value with no special chars, got %q", failed, safe)
+	}
+	t.Logf("\t%s\t Should omit quotes for a value safe to render unquoted", success)
+
+	unsafe := minimal.Print([]*gutrees.Attribute{{Name: "title", Value: "a value with spaces"}})
+	if unsafe != ` title="a value with spaces"` {
+		t.Fatalf("\t%s\t Should still quote a value containing whitespace, got %q", failed, unsafe)
+	}
+	t.Logf("\t%s\t Should still quote a value that requires it for safety", success)
+}
+
+func TestQuoteStyleSingle(t *testing.T) {
+	single := &gutrees.AttrWriter{}
+	single.SetQuoteStyle(gutrees.QuoteSingle)
+
+	out := single.Print([]*gutrees.Attribute{{Name: "class", Value: "card"}})
+	if out != " class='card'" {
+		t.Fatalf("\t%s\t Should single-quote values in QuoteSingle mode, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should single-quote values in QuoteSingle mode", success)
+}
@@ -2,6 +2,8 @@ package gutrees
 
 import (
 	"fmt"
+	"log"
+	"regexp"
 	"strings"
 )
 
@@ -24,12 +26,66 @@ func NewAttr(name, val string) *Attribute {
 	return &a
 }
 
-// Apply applies a set change to the giving element attributes list
+// invalidAttrNameChars matches any character the HTML attribute-name
+// grammar disallows: whitespace, control characters, and '"\'>/=.
+var invalidAttrNameChars = regexp.MustCompile(`[\s"'>/=\x00-\x1f\x7f]`)
+
+// ValidAttrName reports whether name is safe to serialize as an HTML
+// attribute name: non-empty, with none of the characters that would
+// either break out of the tag or be silently mangled by a browser.
+func ValidAttrName(name string) bool {
+	return name != "" && !invalidAttrNameChars.MatchString(name)
+}
+
+// NewValidAttr returns a new attribute instance like NewAttr, but returns
+// ErrInvalidAttrName instead of an attribute when name fails
+// ValidAttrName. Prefer this over NewAttr (or building an *Attribute
+// literal) when name comes from outside the program, since Attribute.Apply
+// has no error return to report that failure through and can only drop
+// the attribute with a logged warning.
+func NewValidAttr(name, val string) (*Attribute, error) {
+	if !ValidAttrName(name) {
+		return nil, ErrInvalidAttrName
+	}
+	return NewAttr(name, val), nil
+}
+
+// Apply applies a set change to the giving element attributes list. An
+// attribute whose Name fails ValidAttrName is dropped with a logged
+// warning rather than applied, since Appliable.Apply has no error return
+// to report it through - construct via NewValidAttr instead to catch an
+// invalid name at creation time. An attribute already present under the
+// same Name has its Value overwritten in place, keeping its original
+// position, rather than being duplicated - the renderer only ever has
+// one value per attribute name to print. A type that wants several
+// applications to merge into one value instead of replacing it (e.g.
+// ClassList, for building up a multi-valued "class") applies its own
+// Apply logic rather than going through this one.
 func (a *Attribute) Apply(e Markup) {
+	if !ValidAttrName(a.Name) {
+		log.Printf("gutrees: dropping attribute with invalid name %q", a.Name)
+		return
+	}
+
 	if em, ok := e.(*Element); ok {
-		if em.allowAttributes {
-			em.attrs = append(em.attrs, a)
+		if em.rejectIfFrozen("Attribute.Apply") {
+			return
 		}
+		if !em.allowAttributes {
+			return
+		}
+
+		if em.attrIndex == nil {
+			em.reindexAttrs()
+		}
+
+		if i, ok := em.attrIndex[a.Name]; ok {
+			em.attrs[i].Value = a.Value
+			return
+		}
+
+		em.attrIndex[a.Name] = len(em.attrs)
+		em.attrs = append(em.attrs, a)
 	}
 }
 
@@ -74,6 +130,9 @@ func (s *Style) Clone() *Style {
 // Apply applies a set change to the giving element style list
 func (s *Style) Apply(e Markup) {
 	if em, ok := e.(*Element); ok {
+		if em.rejectIfFrozen("Style.Apply") {
+			return
+		}
 		if em.allowStyles {
 			em.styles = append(em.styles, s)
 		}
@@ -110,12 +169,16 @@ func (c *ClassList) Apply(em Markup) {
 		return
 	}
 
+	if e.rejectIfFrozen("ClassList.Apply") {
+		return
+	}
+
 	list := strings.Join(*c, " ")
 
 	a, err := GetAttr(e, "class")
 
 	if err != nil {
-		(&Attribute{Name: "class", Value: "list"}).Apply(e)
+		(&Attribute{Name: "class", Value: list}).Apply(e)
 		return
 	}
 
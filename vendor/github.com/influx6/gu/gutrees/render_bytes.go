@@ -0,0 +1,30 @@
+package gutrees
+
+import "bytes"
+
+// RenderInto writes e's rendered HTML into buf using the same streaming
+// core as RenderStreaming, without building an intermediate string first.
+// buf is never retained after RenderInto returns, so callers can pull it
+// from a sync.Pool, reset it, render into it, read out its bytes, then
+// return it to the pool for the next request. It enforces
+// SimpleElementWriter's DefaultMaxDepth; use RenderIntoWith with a writer
+// configured via SetMaxDepth to change that.
+func RenderInto(buf *bytes.Buffer, e *Element) error {
+	return RenderIntoWith(buf, SimpleElementWriter, e)
+}
+
+// RenderIntoWith behaves like RenderInto, but renders with ew instead of
+// SimpleElementWriter, so callers can opt into a custom VoidStyle,
+// QuoteStyle or SetMaxDepth for this render.
+func RenderIntoWith(buf *bytes.Buffer, ew *ElementWriter, e *Element) error {
+	return streamElement(buf, nil, ew, e, nil, 0)
+}
+
+// RenderBytes renders e and returns the result as a freshly allocated
+// []byte. Callers rendering repeatedly (e.g. per HTTP request) should
+// prefer RenderInto with a pooled *bytes.Buffer instead.
+func RenderBytes(e *Element) []byte {
+	var buf bytes.Buffer
+	RenderInto(&buf, e)
+	return buf.Bytes()
+}
@@ -0,0 +1,74 @@
+package gutrees_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestRenderBytesMatchesMarkupWriter(t *testing.T) {
+	tree := buildCard()
+
+	want, err := gutrees.SimpleMarkupWriter.Write(tree)
+	if err != nil {
+		t.Fatalf("\t%s\t Should render via SimpleMarkupWriter without error, got %s", failed, err)
+	}
+
+	if got := string(gutrees.RenderBytes(tree)); got != want {
+		t.Fatalf("\t%s\t Should render the same markup as SimpleMarkupWriter, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should render the same bytes as the string-building path", success)
+}
+
+func TestRenderIntoReusesBuffer(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString("stale contents")
+	buf.Reset()
+
+	tree := buildCard()
+
+	if err := gutrees.RenderInto(&buf, tree); err != nil {
+		t.Fatalf("\t%s\t Should render into a reused buffer without error, got %s", failed, err)
+	}
+
+	if want, _ := gutrees.SimpleMarkupWriter.Write(tree); buf.String() != want {
+		t.Fatalf("\t%s\t Should render the tree into the buffer, got %q want %q", failed, buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := gutrees.RenderInto(&buf, tree); err != nil {
+		t.Fatalf("\t%s\t Should render into the buffer a second time without error, got %s", failed, err)
+	}
+
+	if want, _ := gutrees.SimpleMarkupWriter.Write(tree); buf.String() != want {
+		t.Fatalf("\t%s\t Should render the same tree again after reset, got %q want %q", failed, buf.String(), want)
+	}
+	t.Logf("\t%s\t Should render correctly into a buffer reset and reused across calls", success)
+}
+
+func BenchmarkRenderBytes(b *testing.B) {
+	tree := buildCard()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = gutrees.RenderBytes(tree)
+	}
+}
+
+func BenchmarkRenderIntoPooledBuffer(b *testing.B) {
+	tree := buildCard()
+	var buf bytes.Buffer
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		_ = gutrees.RenderInto(&buf, tree)
+	}
+}
+
+func BenchmarkSimpleMarkupWriterWrite(b *testing.B) {
+	tree := buildCard()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = gutrees.SimpleMarkupWriter.Write(tree)
+	}
+}
@@ -0,0 +1,71 @@
+package gutrees
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// RenderHash returns a quoted, ETag-ready hash of e's rendered form. It
+// streams the same content Print would write directly through sha256
+// instead of building the full HTML string first just to hash it. The
+// randomly generated uid/hash management attributes Print adds are left
+// out, so two structurally identical trees built independently hash
+// equally regardless of construction order.
+func RenderHash(e *Element) string {
+	h := sha256.New()
+	hashElement(h, e)
+	return fmt.Sprintf(`"%x"`, h.Sum(nil))
+}
+
+// hashElement recursively writes e's content (tag, attributes in their
+// existing deterministic order, styles, text, children) to w.
+func hashElement(w io.Writer, e *Element) {
+	if e.Name() == "text" {
+		io.WriteString(w, e.TextContent())
+		return
+	}
+
+	io.WriteString(w, "<"+e.Name())
+
+	for _, a := range e.Attributes() {
+		fmt.Fprintf(w, ` %s="%s"`, a.Name, a.Value)
+	}
+	for _, s := range e.Styles() {
+		fmt.Fprintf(w, " %s:%s;", s.Name, s.Value)
+	}
+
+	io.WriteString(w, ">")
+	io.WriteString(w, e.textContent)
+
+	for _, ch := range e.Children() {
+		if ech, ok := ch.(*Element); ok {
+			hashElement(w, ech)
+		}
+	}
+
+	io.WriteString(w, "</"+e.Name()+">")
+}
+
+// RenderWithETag writes e to w as text/html, setting ETag to RenderHash(e).
+// If the request's If-None-Match already matches that ETag, it responds 304
+// Not Modified with no body instead of rendering again.
+func RenderWithETag(w http.ResponseWriter, r *http.Request, e *Element) error {
+	etag := RenderHash(e)
+	w.Header().Set("ETag", etag)
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	body, err := SimpleMarkupWriter.Write(e)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, err = io.WriteString(w, body)
+	return err
+}
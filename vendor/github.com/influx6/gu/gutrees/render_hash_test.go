@@ -0,0 +1,56 @@
+package gutrees_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func buildCard() *gutrees.Element {
+	return elems.Div(attrs.Class("card"), elems.Paragraph(elems.Text("hello")))
+}
+
+func TestRenderHashIdenticalTreesMatch(t *testing.T) {
+	a := gutrees.RenderHash(buildCard())
+	b := gutrees.RenderHash(buildCard())
+
+	if a != b {
+		t.Fatalf("\t%s\t Should hash two independently built identical trees equally, got %s and %s", failed, a, b)
+	}
+	t.Logf("\t%s\t Should hash structurally identical trees equally", success)
+}
+
+func TestRenderHashChangedAttributeChangesHash(t *testing.T) {
+	original := gutrees.RenderHash(buildCard())
+
+	changed := elems.Div(attrs.Class("card-changed"), elems.Paragraph(elems.Text("hello")))
+	if gutrees.RenderHash(changed) == original {
+		t.Fatalf("\t%s\t Should change the hash when an attribute changes", failed)
+	}
+	t.Logf("\t%s\t Should change the hash when an attribute changes", success)
+}
+
+func TestRenderWithETagRespondsNotModified(t *testing.T) {
+	tree := buildCard()
+	etag := gutrees.RenderHash(tree)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("If-None-Match", etag)
+
+	w := httptest.NewRecorder()
+	if err := gutrees.RenderWithETag(w, r, tree); err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	if w.Code != http.StatusNotModified {
+		t.Fatalf("\t%s\t Should respond 304 when If-None-Match matches, got %d", failed, w.Code)
+	}
+	if w.Body.Len() != 0 {
+		t.Fatalf("\t%s\t Should send no body on 304, got %q", failed, w.Body.String())
+	}
+	t.Logf("\t%s\t Should respond 304 Not Modified when If-None-Match matches the ETag", success)
+}
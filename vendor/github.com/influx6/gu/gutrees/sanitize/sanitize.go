@@ -0,0 +1,128 @@
+// Package sanitize implements allowlist-based cleaning of gutrees trees,
+// for content a program doesn't fully trust - e.g. user-submitted HTML
+// run through gutrees.Parse before it's rendered back out.
+package sanitize
+
+import (
+	"strings"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+// Policy controls what Clean keeps. The zero value keeps nothing; use
+// DefaultPolicy for a conservative starting point and add to it.
+type Policy struct {
+	// AllowedTags is the set of element tag names Clean keeps. An
+	// element whose tag isn't listed here is dropped along with its
+	// entire subtree.
+	AllowedTags map[string]bool
+
+	// AllowedAttrs maps a tag name to the set of attribute names Clean
+	// keeps on elements of that tag. A tag with no entry here keeps
+	// none of its attributes.
+	AllowedAttrs map[string]map[string]bool
+}
+
+// DefaultPolicy returns a conservative Policy covering common text
+// formatting, structural and linking tags - enough for typical
+// user-submitted article or comment content - with their most common
+// attributes allowed. It excludes scripting and embedding tags like
+// <script>, <iframe> and <object> entirely.
+func DefaultPolicy() Policy {
+	return Policy{
+		AllowedTags: map[string]bool{
+			"p": true, "br": true, "hr": true,
+			"b": true, "strong": true, "i": true, "em": true, "u": true, "s": true,
+			"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+			"ul": true, "ol": true, "li": true,
+			"blockquote": true, "code": true, "pre": true,
+			"span": true, "div": true,
+			"a": true, "img": true,
+		},
+		AllowedAttrs: map[string]map[string]bool{
+			"a":   {"href": true, "title": true, "rel": true},
+			"img": {"src": true, "alt": true, "title": true},
+		},
+	}
+}
+
+// urlAttrs are the attributes Clean checks for a javascript: URL,
+// regardless of policy, since a javascript: href or src executes script
+// no matter which tag or attribute carries it.
+var urlAttrs = map[string]bool{"href": true, "src": true}
+
+// Clean walks root and builds a new tree containing only what policy
+// allows: an element whose tag isn't in policy.AllowedTags is dropped
+// along with its subtree; an attribute not in
+// policy.AllowedAttrs[tag] is dropped; an "on*" event attribute and a
+// javascript: URL in href/src are dropped regardless of policy. Inline
+// styles are dropped unconditionally - Policy has no allowlist for CSS
+// properties, so the conservative choice is to drop them all rather than
+// let arbitrary declarations through. root itself is left untouched;
+// Clean returns an independent tree.
+func Clean(root *gutrees.Element, policy Policy) *gutrees.Element {
+	cleaned := cleanElement(root, policy)
+	if cleaned == nil {
+		return gutrees.NewFragment()
+	}
+	return cleaned
+}
+
+// cleanElement rebuilds e under policy, or returns nil if e's tag isn't
+// allowed at all.
+func cleanElement(e *gutrees.Element, policy Policy) *gutrees.Element {
+	name := e.Name()
+
+	if name == "text" {
+		return gutrees.NewText(e.TextContent())
+	}
+
+	if name != "fragment" && !policy.AllowedTags[name] {
+		return nil
+	}
+
+	out := gutrees.NewElement(name, e.AutoClosed())
+
+	allowed := policy.AllowedAttrs[name]
+	for _, a := range e.Attributes() {
+		if !allowed[a.Name] {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(a.Name), "on") {
+			continue
+		}
+		if urlAttrs[a.Name] && isJavascriptURL(a.Value) {
+			continue
+		}
+		a.Clone().Apply(out)
+	}
+
+	for _, ch := range e.Children() {
+		ec, ok := ch.(*gutrees.Element)
+		if !ok {
+			continue
+		}
+		if cleanedChild := cleanElement(ec, policy); cleanedChild != nil {
+			cleanedChild.Apply(out)
+		}
+	}
+
+	return out
+}
+
+// stripURLWhitespace removes every tab, newline and carriage return from
+// val, wherever it occurs - not just at the ends - the way the WHATWG URL
+// spec has a browser strip them before parsing a URL's scheme. Without
+// this, "jav\tascript:alert(1)" would pass an isJavascriptURL check based
+// on TrimSpace alone, even though a browser still parses and executes it
+// as a javascript: URL.
+func stripURLWhitespace(val string) string {
+	return strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(val)
+}
+
+// isJavascriptURL reports whether val is a javascript: URL, ignoring
+// embedded tab/newline/CR characters, surrounding whitespace and letter
+// case the way browsers do when deciding whether to execute it.
+func isJavascriptURL(val string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(stripURLWhitespace(val))), "javascript:")
+}
@@ -0,0 +1,84 @@
+package sanitize_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/sanitize"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestCleanStripsDisallowedTag(t *testing.T) {
+	tree, err := gutrees.Parse(`<p>hello<script>alert(1)</script></p>`)
+	if err != nil {
+		t.Fatalf("\t%s\t Should parse without error, got %s", failed, err)
+	}
+
+	cleaned := sanitize.Clean(tree, sanitize.DefaultPolicy())
+	out := string(gutrees.RenderBytes(cleaned))
+
+	if strings.Contains(out, "script") || strings.Contains(out, "alert(1)") {
+		t.Fatalf("\t%s\t Should strip the script tag and its content, got %q", failed, out)
+	}
+	if !strings.Contains(out, "hello") {
+		t.Fatalf("\t%s\t Should keep the allowed sibling text, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should strip a disallowed <script> tag and its subtree", success)
+}
+
+func TestCleanDropsJavascriptHref(t *testing.T) {
+	tree, err := gutrees.Parse(`<a href="javascript:alert(1)">click</a>`)
+	if err != nil {
+		t.Fatalf("\t%s\t Should parse without error, got %s", failed, err)
+	}
+
+	cleaned := sanitize.Clean(tree, sanitize.DefaultPolicy())
+	out := string(gutrees.RenderBytes(cleaned))
+
+	if strings.Contains(out, "javascript:") {
+		t.Fatalf("\t%s\t Should drop a javascript: href, got %q", failed, out)
+	}
+	if !strings.Contains(out, "<a") || !strings.Contains(out, "click") {
+		t.Fatalf("\t%s\t Should keep the anchor and its text, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should drop a javascript: URL while keeping the element", success)
+}
+
+func TestCleanDropsJavascriptHrefWithEmbeddedWhitespace(t *testing.T) {
+	tree, err := gutrees.Parse("<a href=\"jav\tascript:alert(1)\">click</a>")
+	if err != nil {
+		t.Fatalf("\t%s\t Should parse without error, got %s", failed, err)
+	}
+
+	cleaned := sanitize.Clean(tree, sanitize.DefaultPolicy())
+	out := string(gutrees.RenderBytes(cleaned))
+
+	if strings.Contains(out, "javascript:") {
+		t.Fatalf("\t%s\t Should drop a javascript: href with an embedded tab, got %q", failed, out)
+	}
+	if !strings.Contains(out, "<a") || !strings.Contains(out, "click") {
+		t.Fatalf("\t%s\t Should keep the anchor and its text, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should drop a javascript: URL with embedded whitespace while keeping the element", success)
+}
+
+func TestCleanDropsEventAttribute(t *testing.T) {
+	tree, err := gutrees.Parse(`<img src="a.png" onerror="alert(1)">`)
+	if err != nil {
+		t.Fatalf("\t%s\t Should parse without error, got %s", failed, err)
+	}
+
+	policy := sanitize.DefaultPolicy()
+	policy.AllowedAttrs["img"]["onerror"] = true // even if explicitly allowed, on* is always dropped
+
+	cleaned := sanitize.Clean(tree, policy)
+	out := string(gutrees.RenderBytes(cleaned))
+
+	if strings.Contains(out, "onerror") {
+		t.Fatalf("\t%s\t Should drop an on* event attribute regardless of policy, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should drop on* event attributes unconditionally", success)
+}
@@ -0,0 +1,53 @@
+package gutrees
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// slugUnsafe matches runs of characters a URL fragment id shouldn't
+// contain, collapsed to a single "-" by slugify.
+var slugUnsafe = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify lowercases s and replaces every run of non-alphanumeric
+// characters with a single hyphen, trimming any leading or trailing one.
+func slugify(s string) string {
+	return strings.Trim(slugUnsafe.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
+
+// SlugifyHeadings walks root and its descendants, and for each heading
+// (h1-h6) with no id already set, derives one from its text content via
+// slugify and sets it as the "id" attribute - giving docs pages anchor
+// links without hand-assigning one per heading. A heading whose slug
+// collides with one already assigned gets a "-1", "-2", ... suffix, so
+// two headings both titled "Foo" produce "foo" and "foo-1".
+func SlugifyHeadings(root *Element) {
+	seen := map[string]int{}
+
+	Walk(root, func(e *Element) bool {
+		if _, ok := headingLevel(e.Name()); !ok {
+			return true
+		}
+		if _, err := GetAttr(e, "id"); err == nil {
+			return true
+		}
+
+		base := slugify(elementText(e))
+		if base == "" {
+			return true
+		}
+
+		id := base
+		if n, ok := seen[base]; ok {
+			n++
+			seen[base] = n
+			id = base + "-" + strconv.Itoa(n)
+		} else {
+			seen[base] = 0
+		}
+
+		(&Attribute{Name: "id", Value: id}).Apply(e)
+		return true
+	})
+}
@@ -0,0 +1,46 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestSlugifyHeadingsDeduplicatesIdenticalText(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+
+	h1 := gutrees.NewElement("h1", false)
+	gutrees.NewText("Foo").Apply(h1)
+	h1.Apply(root)
+
+	h2 := gutrees.NewElement("h2", false)
+	gutrees.NewText("Foo").Apply(h2)
+	h2.Apply(root)
+
+	gutrees.SlugifyHeadings(root)
+
+	first, err := gutrees.GetAttr(h1, "id")
+	if err != nil || first.Value != "foo" {
+		t.Fatalf("\t%s\t Should slug the first heading as 'foo', got %+v err %v", failed, first, err)
+	}
+
+	second, err := gutrees.GetAttr(h2, "id")
+	if err != nil || second.Value != "foo-1" {
+		t.Fatalf("\t%s\t Should slug the colliding heading as 'foo-1', got %+v err %v", failed, second, err)
+	}
+	t.Logf("\t%s\t Should deduplicate identical heading text with a numeric suffix", success)
+}
+
+func TestSlugifyHeadingsLeavesExistingIDAlone(t *testing.T) {
+	h1 := gutrees.NewElement("h1", false)
+	gutrees.NewText("Foo").Apply(h1)
+	(&gutrees.Attribute{Name: "id", Value: "custom"}).Apply(h1)
+
+	gutrees.SlugifyHeadings(h1)
+
+	id, err := gutrees.GetAttr(h1, "id")
+	if err != nil || id.Value != "custom" {
+		t.Fatalf("\t%s\t Should leave an explicitly set id untouched, got %+v err %v", failed, id, err)
+	}
+	t.Logf("\t%s\t Should not overwrite a heading's existing id", success)
+}
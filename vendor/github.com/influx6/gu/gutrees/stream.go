@@ -0,0 +1,187 @@
+package gutrees
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-humble/detect"
+)
+
+// RenderStreaming writes e to w element by element in document order,
+// flushing immediately after any element for which flushAt returns true,
+// so slow pages can get above-the-fold content (like <head>) to the
+// browser before the rest is ready. If w doesn't implement http.Flusher,
+// it degrades to an ordinary write with no flush calls. It enforces
+// SimpleElementWriter's DefaultMaxDepth; use RenderStreamingWith with a
+// writer configured via SetMaxDepth to change that.
+func RenderStreaming(w io.Writer, e *Element, flushAt func(*Element) bool) error {
+	return RenderStreamingWith(w, SimpleElementWriter, e, flushAt)
+}
+
+// RenderStreamingWith behaves like RenderStreaming, but renders with ew
+// instead of SimpleElementWriter, so callers can opt into a custom
+// VoidStyle, QuoteStyle or SetMaxDepth for this render.
+func RenderStreamingWith(w io.Writer, ew *ElementWriter, e *Element, flushAt func(*Element) bool) error {
+	flusher, _ := w.(http.Flusher)
+	return streamElement(w, flusher, ew, e, flushAt, 0)
+}
+
+// streamElement writes e using the same layout as ElementWriter.Print, but
+// directly to w as each piece is produced instead of building one big
+// string first, so a flush boundary partway through the tree actually
+// reaches the client early. depth is e's distance from the original root,
+// checked against ew's configured max depth so a runaway or
+// self-referential tree fails fast with ErrMaxDepthExceeded instead of
+// overflowing the stack.
+func streamElement(w io.Writer, flusher http.Flusher, ew *ElementWriter, e *Element, flushAt func(*Element) bool, depth int) error {
+	if depth > ew.depthLimit() {
+		return ErrMaxDepthExceeded
+	}
+
+	if ew.hooks.OnEnter != nil {
+		ew.hooks.OnEnter(e)
+	}
+	if ew.hooks.OnExit != nil {
+		start := time.Now()
+		defer func() {
+			ew.hooks.OnExit(e, time.Since(start))
+		}()
+	}
+
+	if detect.IsServer() {
+		if e.Removed() && !ew.allowRemoved {
+			return nil
+		}
+	}
+
+	if e.Name() == "text" {
+		_, err := io.WriteString(w, ew.text.Print(e))
+		return err
+	}
+
+	if e.Name() == "custom-render" {
+		if e.customRenderer == nil {
+			return nil
+		}
+		if err := e.customRenderer.RenderSelf(w); err != nil {
+			return err
+		}
+		return maybeFlush(flusher, e, flushAt)
+	}
+
+	if e.Name() == "fragment" {
+		for _, ch := range e.Children() {
+			if ech, ok := ch.(*Element); ok {
+				if err := streamElement(w, flusher, ew, ech, flushAt, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return maybeFlush(flusher, e, flushAt)
+	}
+
+	if e.Name() == "conditional-comment" {
+		var condition string
+		if attr, err := GetAttr(e, "data-condition"); err == nil {
+			condition = attr.Value
+		}
+		if _, err := fmt.Fprintf(w, "<!--[if %s]>", condition); err != nil {
+			return err
+		}
+		for _, ch := range e.Children() {
+			if ech, ok := ch.(*Element); ok {
+				if err := streamElement(w, flusher, ew, ech, flushAt, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		if _, err := io.WriteString(w, "<![endif]-->"); err != nil {
+			return err
+		}
+		return maybeFlush(flusher, e, flushAt)
+	}
+
+	if e.Name() == "lazy" {
+		resolved := e.resolveLazy()
+		if resolved == nil {
+			return nil
+		}
+		if err := streamElement(w, flusher, ew, resolved, flushAt, depth+1); err != nil {
+			return err
+		}
+		return maybeFlush(flusher, e, flushAt)
+	}
+
+	hash := &Attribute{"hash", e.Hash()}
+	uid := &Attribute{"uid", e.UID()}
+
+	if err := writeAll(w,
+		"<"+e.Name(),
+		ew.attrWriter.Print([]*Attribute{hash, uid}),
+		ew.attrWriter.Print(e.Attributes()),
+	); err != nil {
+		return err
+	}
+
+	if style := ew.styleWriter.Print(e.Styles()); style != "" {
+		if _, err := fmt.Fprintf(w, ` style="%s"`, style); err != nil {
+			return err
+		}
+	}
+
+	if ew.isVoid(e) {
+		closer := "/>"
+		switch ew.voidStyle {
+		case VoidSelfClosingSpaced:
+			closer = " />"
+		case VoidHTML5:
+			closer = ">"
+		}
+		if _, err := io.WriteString(w, closer); err != nil {
+			return err
+		}
+		return maybeFlush(flusher, e, flushAt)
+	}
+
+	if err := writeAll(w, ">", e.textContent); err != nil {
+		return err
+	}
+
+	for _, ch := range e.Children() {
+		if ech, ok := ch.(*Element); ok {
+			if ech == e {
+				continue
+			}
+			if err := streamElement(w, flusher, ew, ech, flushAt, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := io.WriteString(w, "</"+e.Name()+">"); err != nil {
+		return err
+	}
+
+	return maybeFlush(flusher, e, flushAt)
+}
+
+// maybeFlush flushes w after e when flushAt marks it as a boundary and w
+// actually supports flushing.
+func maybeFlush(flusher http.Flusher, e *Element, flushAt func(*Element) bool) error {
+	if flusher != nil && flushAt != nil && flushAt(e) {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// writeAll writes each piece to w in order, stopping at the first error.
+func writeAll(w io.Writer, pieces ...string) error {
+	for _, piece := range pieces {
+		if _, err := io.WriteString(w, piece); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package gutrees_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// recordingFlusher wraps a bytes.Buffer and records the length written at
+// each Flush call, so a test can tell when flushing happened relative to
+// the content written.
+type recordingFlusher struct {
+	bytes.Buffer
+	flushedAt []int
+}
+
+func (r *recordingFlusher) Flush() {
+	r.flushedAt = append(r.flushedAt, r.Buffer.Len())
+}
+
+func TestRenderStreamingFlushesAtMarkedBoundary(t *testing.T) {
+	header := elems.Header(attrs.Class("head"), elems.Text("head"))
+	body := elems.Paragraph(elems.Text("body"))
+	tree := elems.Div(header, body)
+
+	w := &recordingFlusher{}
+
+	err := gutrees.RenderStreaming(w, tree, func(e *gutrees.Element) bool {
+		c, _ := gutrees.GetAttr(e, "class")
+		return c != nil && c.Value == "head"
+	})
+	if err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	if len(w.flushedAt) != 1 {
+		t.Fatalf("\t%s\t Should flush exactly once at the marked boundary, got %d flushes", failed, len(w.flushedAt))
+	}
+
+	flushedContent := w.Buffer.String()[:w.flushedAt[0]]
+	if !strings.Contains(flushedContent, "head") {
+		t.Fatalf("\t%s\t Should have written the header before flushing, got %q", failed, flushedContent)
+	}
+	if strings.Contains(flushedContent, "body") {
+		t.Fatalf("\t%s\t Should not have written the body yet at flush time, got %q", failed, flushedContent)
+	}
+	t.Logf("\t%s\t Should flush immediately after the marked boundary element", success)
+}
+
+func TestRenderStreamingDegradesWithoutFlusher(t *testing.T) {
+	tree := elems.Paragraph(elems.Text("hi"))
+
+	var buf bytes.Buffer
+	err := gutrees.RenderStreaming(&buf, tree, func(e *gutrees.Element) bool { return true })
+	if err != nil {
+		t.Fatalf("\t%s\t Should render without error when w isn't a Flusher, got %s", failed, err)
+	}
+	if !strings.Contains(buf.String(), "hi") {
+		t.Fatalf("\t%s\t Should still write the content, got %q", failed, buf.String())
+	}
+	t.Logf("\t%s\t Should degrade gracefully when w doesn't implement http.Flusher", success)
+}
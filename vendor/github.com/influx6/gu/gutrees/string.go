@@ -0,0 +1,44 @@
+package gutrees
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String returns a compact one-line summary of e for logging and
+// debugging, e.g. "<div#id.class1.class2 attrs=3 children=5>". It's not a
+// rendering of e - use the ElementWriter/markupWriter machinery for that.
+// A text node summarizes as `#text "first 20 chars…"`, truncating only
+// when its content is actually longer than 20 runes.
+func (e *Element) String() string {
+	if e.Name() == "text" {
+		return fmt.Sprintf("#text %q", truncateRunes(e.TextContent(), 20))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<%s", e.Name())
+
+	if id, err := GetAttr(e, "id"); err == nil && id.Value != "" {
+		b.WriteString("#" + id.Value)
+	}
+
+	if class, err := GetAttr(e, "class"); err == nil && class.Value != "" {
+		for _, c := range strings.Fields(class.Value) {
+			b.WriteString("." + c)
+		}
+	}
+
+	fmt.Fprintf(&b, " attrs=%d children=%d>", len(e.Attributes()), len(e.Children()))
+
+	return b.String()
+}
+
+// truncateRunes returns s unchanged if it has n runes or fewer, otherwise
+// its first n runes followed by an ellipsis.
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "…"
+}
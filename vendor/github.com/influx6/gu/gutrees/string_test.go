@@ -0,0 +1,43 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestElementStringSummary(t *testing.T) {
+	e := elems.Div(
+		attrs.ID("card"),
+		attrs.Class("card", "card-highlighted"),
+		attrs.Placeholder("unused"),
+		elems.Span(),
+	)
+
+	want := "<div#card.card.card-highlighted attrs=3 children=1>"
+	if got := e.String(); got != want {
+		t.Fatalf("\t%s\t Should summarize id, classes, attr count and child count, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should produce a compact debug summary", success)
+}
+
+func TestElementStringTruncatesLongText(t *testing.T) {
+	text := elems.Text("this text is definitely longer than twenty characters")
+
+	want := `#text "this text is definit…"`
+	if got := text.String(); got != want {
+		t.Fatalf("\t%s\t Should truncate text content to 20 runes plus an ellipsis, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should truncate a long text node's summary", success)
+}
+
+func TestElementStringKeepsShortTextWhole(t *testing.T) {
+	text := elems.Text("short")
+
+	want := `#text "short"`
+	if got := text.String(); got != want {
+		t.Fatalf("\t%s\t Should not add an ellipsis to text already under the limit, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should leave a short text node's summary untruncated", success)
+}
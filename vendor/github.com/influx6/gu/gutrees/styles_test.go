@@ -0,0 +1,41 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/styles"
+)
+
+func TestRemoveStyle(t *testing.T) {
+	elem := gutrees.NewElement("div", false)
+	styles.Color("red").Apply(elem)
+	styles.Display("block").Apply(elem)
+	styles.Margin(styles.Px(10)).Apply(elem)
+
+	elem.RemoveStyle("display")
+
+	if _, ok := elem.Style("display"); ok {
+		t.Fatalf("\t%s\t Should have removed the 'display' property", failed)
+	}
+	t.Logf("\t%s\t Should have removed the 'display' property", success)
+
+	remaining := elem.Styles()
+	if len(remaining) != 2 {
+		t.Fatalf("\t%s\t Should have retained the other two properties but got %d", failed, len(remaining))
+	}
+	t.Logf("\t%s\t Should have retained the other two properties", success)
+
+	if remaining[0].Name != "color" || remaining[1].Name != "margin" {
+		t.Fatalf("\t%s\t Should have preserved the order of the remaining properties", failed)
+	}
+	t.Logf("\t%s\t Should have preserved the order of the remaining properties", success)
+
+	elem.RemoveStyle("color")
+	elem.RemoveStyle("margin")
+
+	if len(elem.Styles()) != 0 {
+		t.Fatalf("\t%s\t Should have no styles left", failed)
+	}
+	t.Logf("\t%s\t Should have no styles left", success)
+}
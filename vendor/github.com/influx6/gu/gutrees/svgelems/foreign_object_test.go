@@ -0,0 +1,35 @@
+package svgelems_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+	"github.com/influx6/gu/gutrees/svgelems"
+)
+
+func TestForeignObjectStampsXHTMLNamespaceOnHTMLChild(t *testing.T) {
+	tree := svgelems.SVG(svgelems.ForeignObject(elems.Div(elems.Text("hello"))))
+
+	out, err := gutrees.SimpleMarkupWriter.Write(tree)
+	if err != nil {
+		t.Fatalf("\t%s\t Should write without error, got %s", failed, err)
+	}
+
+	// NewElement lowercases every tag name; this is harmless for
+	// foreignObject specifically since the HTML5 parsing algorithm's SVG
+	// tag-name adjustment table already re-cases "foreignobject" back to
+	// "foreignObject" when parsing an HTML document, which is what gu
+	// renders for.
+	if !strings.Contains(out, "<foreignobject") {
+		t.Fatalf("\t%s\t Should render a foreignObject element, got %s", failed, out)
+	}
+	if !strings.Contains(out, `xmlns="http://www.w3.org/1999/xhtml"`) {
+		t.Fatalf("\t%s\t Should stamp the HTML child with the XHTML namespace, got %s", failed, out)
+	}
+	if !strings.Contains(out, "hello") {
+		t.Fatalf("\t%s\t Should render the wrapped HTML content, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should render an HTML div inside foreignObject with the correct namespace boundary", success)
+}
@@ -0,0 +1,51 @@
+// Package svgelems provides constructors for the small set of SVG elements
+// this codebase needs, mirroring elems' style for HTML elements.
+package svgelems
+
+import "github.com/influx6/gu/gutrees"
+
+// SVG provides the <svg> root element.
+func SVG(markup ...gutrees.Appliable) *gutrees.Element {
+	e := gutrees.NewElement("svg", false)
+	for _, m := range markup {
+		m.Apply(e)
+	}
+	return e
+}
+
+// xhtmlNamespace is the namespace that switches parsing back to HTML
+// inside an SVG foreignObject's content.
+const xhtmlNamespace = "http://www.w3.org/1999/xhtml"
+
+// ForeignObject builds an SVG <foreignObject>, for embedding ordinary
+// HTML widgets inside an SVG tree. Every direct *Element child is stamped
+// with xmlns="http://www.w3.org/1999/xhtml", the standard way to tell a
+// parser its content is HTML rather than more SVG - an SVG document has
+// no other way to flip namespace mid-tree, since unlike HTML5's built-in
+// foreignObject handling, a plain XML/SVG parser doesn't infer it from
+// context.
+func ForeignObject(markup ...gutrees.Appliable) *gutrees.Element {
+	e := gutrees.NewElement("foreignObject", false)
+	for _, m := range markup {
+		m.Apply(e)
+	}
+
+	for _, ch := range e.Children() {
+		if ech, ok := ch.(*gutrees.Element); ok {
+			(&gutrees.Attribute{Name: "xmlns", Value: xhtmlNamespace}).Apply(ech)
+		}
+	}
+
+	return e
+}
+
+// Use emits a self-closing <use> element referencing an icon in an SVG
+// sprite, e.g. Use("#icon-star"). Both "href" (the modern attribute) and
+// "xlink:href" (still required by some renderers) are set so the reference
+// resolves everywhere.
+func Use(href string) *gutrees.Element {
+	e := gutrees.NewElement("use", true)
+	(&gutrees.Attribute{Name: "href", Value: href}).Apply(e)
+	(&gutrees.Attribute{Name: "xlink:href", Value: href}).Apply(e)
+	return e
+}
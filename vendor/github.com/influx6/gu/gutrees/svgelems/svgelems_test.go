@@ -0,0 +1,33 @@
+package svgelems_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+	"github.com/influx6/gu/gutrees/svgelems"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestUseInsideSVGWithinDiv(t *testing.T) {
+	tree := elems.Div(svgelems.SVG(svgelems.Use("#icon-star")))
+
+	out, err := gutrees.SimpleMarkupWriter.Write(tree)
+	if err != nil {
+		t.Fatalf("\t%s\t Should write without error, got %s", failed, err)
+	}
+
+	if !strings.Contains(out, `href="#icon-star"`) {
+		t.Fatalf("\t%s\t Should set href, got %s", failed, out)
+	}
+	if !strings.Contains(out, `xlink:href="#icon-star"`) {
+		t.Fatalf("\t%s\t Should set xlink:href for older renderers, got %s", failed, out)
+	}
+	if !strings.Contains(out, "<use") || !strings.Contains(out, "/>") {
+		t.Fatalf("\t%s\t Should render <use> as self-closing, got %s", failed, out)
+	}
+	t.Logf("\t%s\t Should render a namespaced, self-closing <use> inside <svg>", success)
+}
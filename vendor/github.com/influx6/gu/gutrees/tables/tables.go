@@ -0,0 +1,47 @@
+// Package tables provides convenience builders for html tables, avoiding the
+// verbosity of composing elems.Table/TableHead/TableBody/TableRow by hand.
+package tables
+
+import (
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+// FromRows builds a complete <table> from a list of header labels and a
+// matrix of row values, producing a <thead> of <th> cells and a <tbody> of
+// <td> cells. All values are rendered as text nodes.
+func FromRows(headers []string, rows [][]string) *gutrees.Element {
+	var head []gutrees.Appliable
+	for _, h := range headers {
+		head = append(head, elems.TableHeader(elems.Text(h)))
+	}
+
+	var body []gutrees.Appliable
+	for _, row := range rows {
+		var cells []gutrees.Appliable
+		for _, val := range row {
+			cells = append(cells, elems.TableData(elems.Text(val)))
+		}
+		body = append(body, elems.TableRow(cells...))
+	}
+
+	return elems.Table(
+		elems.TableHead(elems.TableRow(head...)),
+		elems.TableBody(body...),
+	)
+}
+
+// Row maps a value of any type into a slice of cell text, used by
+// FromStructs to turn a slice of structs into table rows.
+type Row func(v interface{}) []string
+
+// FromStructs builds a table from a slice of values using a caller-supplied
+// mapping callback to turn each value into its row of cell text.
+func FromStructs(headers []string, values []interface{}, row Row) *gutrees.Element {
+	rows := make([][]string, len(values))
+	for i, v := range values {
+		rows[i] = row(v)
+	}
+
+	return FromRows(headers, rows)
+}
@@ -0,0 +1,43 @@
+package tables_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/tables"
+)
+
+var success = "✓"
+var failed = "✗"
+
+func TestFromRows(t *testing.T) {
+	table := tables.FromRows(
+		[]string{"Name", "Amount"},
+		[][]string{
+			{"Rent", "$1200"},
+			{"Groceries", "$300"},
+			{"Utilities", "$90"},
+		},
+	)
+
+	thead := table.Children()[0].(*gutrees.Element)
+	tbody := table.Children()[1].(*gutrees.Element)
+
+	headerRow := thead.Children()[0].(*gutrees.Element)
+	if len(headerRow.Children()) != 2 {
+		t.Fatalf("\t%s\t Should render 2 header cells, got %d", failed, len(headerRow.Children()))
+	}
+	t.Logf("\t%s\t Should render the correct number of header cells", success)
+
+	if len(tbody.Children()) != 3 {
+		t.Fatalf("\t%s\t Should render 3 body rows, got %d", failed, len(tbody.Children()))
+	}
+	t.Logf("\t%s\t Should render the correct number of body rows", success)
+
+	firstCell := tbody.Children()[0].(*gutrees.Element).Children()[0].(*gutrees.Element)
+	firstText := firstCell.Children()[0].(*gutrees.Element)
+	if firstText.TextContent() != "Rent" {
+		t.Fatalf("\t%s\t Should preserve cell order and text, got %q", failed, firstText.TextContent())
+	}
+	t.Logf("\t%s\t Should preserve cell order and text", success)
+}
@@ -0,0 +1,45 @@
+package gutrees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestSetTagNameRenamesAndRerendersAs(t *testing.T) {
+	e := elems.Header1(elems.Text("title"))
+
+	if e.TagName() != "h1" {
+		t.Fatalf("\t%s\t Should report the tag name set at construction, got %q", failed, e.TagName())
+	}
+
+	e.SetTagName("h2", false)
+
+	if e.TagName() != "h2" {
+		t.Fatalf("\t%s\t Should report the renamed tag, got %q", failed, e.TagName())
+	}
+
+	out := string(gutrees.RenderBytes(e))
+	if !strings.Contains(out, "<h2") || !strings.Contains(out, "</h2>") || strings.Contains(out, "<h1") {
+		t.Fatalf("\t%s\t Should render using the renamed tag, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should rename the tag and render under the new name", success)
+}
+
+func TestSetTagNameUpdatesAutoclose(t *testing.T) {
+	e := elems.Div()
+
+	e.SetTagName("br", true)
+
+	if !e.IsVoid() {
+		t.Fatalf("\t%s\t Should update the autoclose flag to match the new tag", failed)
+	}
+
+	out := string(gutrees.RenderBytes(e))
+	if !strings.Contains(out, "<br") || strings.Contains(out, "</br>") {
+		t.Fatalf("\t%s\t Should render as a self-closing tag after renaming to a void element, got %q", failed, out)
+	}
+	t.Logf("\t%s\t Should update autoclose behavior when renaming to a void tag", success)
+}
@@ -0,0 +1,35 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/guevents"
+	"github.com/influx6/gu/gutrees"
+)
+
+func TestLoadEventsSkipsHandlersInsideTemplate(t *testing.T) {
+	root := gutrees.NewElement("div", false)
+	live := gutrees.NewElement("button", false)
+	live.Apply(root)
+
+	tmpl := gutrees.NewElement("template", false)
+	tmpl.Apply(root)
+	inert := gutrees.NewElement("button", false)
+	inert.Apply(tmpl)
+
+	gutrees.NewEvent("click", "", func(guevents.Event, gutrees.Markup) {}).Apply(live)
+	gutrees.NewEvent("click", "", func(guevents.Event, gutrees.Markup) {}).Apply(inert)
+
+	manager := guevents.NewEventManager()
+	root.UseEventManager(manager)
+
+	if !manager.HasEvent(guevents.BuildEventID(live.EventID(), "click")) {
+		t.Fatalf("\t%s\t Should register the event of a live child", failed)
+	}
+	t.Logf("\t%s\t Should register the event of a live child", success)
+
+	if manager.HasEvent(guevents.BuildEventID(inert.EventID(), "click")) {
+		t.Fatalf("\t%s\t Should not register an event for a node inside a <template>", failed)
+	}
+	t.Logf("\t%s\t Should not register an event for a node inside a <template>", success)
+}
@@ -0,0 +1,55 @@
+package gutrees
+
+import "strings"
+
+// blockTextTags are the tags RenderText treats as starting a new line of
+// their own, the way a browser visually separates block-level content.
+var blockTextTags = map[string]bool{
+	"p": true, "div": true, "section": true, "article": true,
+	"header": true, "footer": true, "blockquote": true, "pre": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "li": true, "tr": true,
+}
+
+// RenderText walks root and produces a readable plaintext rendering of
+// it, useful for a plaintext email alternative built from the same tree
+// as the HTML one. It's a pragmatic approximation of what a browser's
+// innerText would show, not a full HTML-to-text converter: a block
+// element (p, div, headings, list items, ...) starts a new line, <br>
+// becomes a newline, a list item is prefixed with "- ", and a link
+// renders as "text (url)" rather than just its text.
+func RenderText(root *Element) string {
+	var b strings.Builder
+
+	Walk(root, func(e *Element) bool {
+		switch e.Name() {
+		case "text":
+			b.WriteString(e.TextContent())
+			return true
+
+		case "br":
+			b.WriteString("\n")
+			return true
+
+		case "a":
+			text := elementText(e)
+			if href, err := GetAttr(e, "href"); err == nil {
+				b.WriteString(text + " (" + href.Value + ")")
+			} else {
+				b.WriteString(text)
+			}
+			return false
+		}
+
+		if blockTextTags[e.Name()] && b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		if e.Name() == "li" {
+			b.WriteString("- ")
+		}
+
+		return true
+	})
+
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,28 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestRenderTextRendersBlocksBreaksListsAndLinks(t *testing.T) {
+	tree := elems.Div(
+		elems.Paragraph(elems.Text("Hello"), elems.Break(), elems.Text("World")),
+		elems.UnorderedList(
+			elems.ListItem(elems.Text("first")),
+			elems.ListItem(elems.Text("second")),
+		),
+		elems.Paragraph(elems.Anchor(attrs.Href("https://example.com"), elems.Text("docs"))),
+	)
+
+	got := gutrees.RenderText(tree)
+	want := "Hello\nWorld\n\n- first\n- second\ndocs (https://example.com)"
+
+	if got != want {
+		t.Fatalf("\t%s\t Should render a readable plaintext approximation, got %q want %q", failed, got, want)
+	}
+	t.Logf("\t%s\t Should render blocks, breaks, list bullets and links as plaintext", success)
+}
@@ -0,0 +1,51 @@
+package gutrees
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TrailerRenderTime and TrailerRenderSize are the trailer header names
+// RenderWithTrailer sets once streaming finishes.
+const (
+	TrailerRenderTime = "X-Render-Time"
+	TrailerRenderSize = "X-Render-Size"
+)
+
+// RenderWithTrailer streams e to w via RenderStreaming, then sets a
+// render-timing and output-size trailer - observability that has to come
+// after the body, since neither value is known until rendering is done.
+// The HTTP/1.1 trailer mechanism requires every trailer name to be
+// announced via the "Trailer" header before the first byte of the body is
+// written, which is why TrailerRenderTime/TrailerRenderSize are set on
+// w.Header() up front and only given their real values afterwards.
+func RenderWithTrailer(w http.ResponseWriter, e *Element) error {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Trailer", TrailerRenderTime+", "+TrailerRenderSize)
+
+	counter := &countingWriter{w: w}
+
+	start := time.Now()
+	err := RenderStreaming(counter, e, nil)
+	duration := time.Since(start)
+
+	w.Header().Set(TrailerRenderTime, duration.String())
+	w.Header().Set(TrailerRenderSize, fmt.Sprintf("%d", counter.n))
+
+	return err
+}
+
+// countingWriter wraps an io.Writer, tallying the bytes written through
+// it so RenderWithTrailer can report a body size after the fact without
+// buffering the rendered output to measure it.
+type countingWriter struct {
+	w http.ResponseWriter
+	n int
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += n
+	return n, err
+}
@@ -0,0 +1,41 @@
+package gutrees_test
+
+import (
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestRenderWithTrailerPopulatesTrailerAfterStreaming(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	if err := gutrees.RenderWithTrailer(w, elems.Div(elems.Text("hello"))); err != nil {
+		t.Fatalf("\t%s\t Should render without error, got %s", failed, err)
+	}
+
+	announced := w.Header().Get("Trailer")
+	if !strings.Contains(announced, gutrees.TrailerRenderTime) || !strings.Contains(announced, gutrees.TrailerRenderSize) {
+		t.Fatalf("\t%s\t Should announce both trailer names up front, got %q", failed, announced)
+	}
+	t.Logf("\t%s\t Should announce the trailer names before streaming the body", success)
+
+	if w.Header().Get(gutrees.TrailerRenderTime) == "" {
+		t.Fatalf("\t%s\t Should set a render-time trailer value", failed)
+	}
+	t.Logf("\t%s\t Should set the render-time trailer after streaming completes", success)
+
+	size, err := strconv.Atoi(w.Header().Get(gutrees.TrailerRenderSize))
+	if err != nil || size != w.Body.Len() {
+		t.Fatalf("\t%s\t Should set the render-size trailer to the actual body length, got %q for a %d-byte body", failed, w.Header().Get(gutrees.TrailerRenderSize), w.Body.Len())
+	}
+	t.Logf("\t%s\t Should set the render-size trailer to the streamed body length", success)
+
+	if !strings.Contains(w.Body.String(), "hello") {
+		t.Fatalf("\t%s\t Should stream the rendered content, got %s", failed, w.Body.String())
+	}
+	t.Logf("\t%s\t Should stream the rendered content to the body", success)
+}
@@ -0,0 +1,70 @@
+package gutrees
+
+import (
+	"strings"
+)
+
+// urlAttrNames are the attributes RewriteURLs considers, holding a URL
+// (or, for srcset, a list of them) rather than arbitrary text.
+var urlAttrNames = map[string]bool{
+	"href":   true,
+	"src":    true,
+	"srcset": true,
+	"poster": true,
+}
+
+// RewriteURLs walks root and its descendants, replacing every "href",
+// "src", "srcset" and "poster" attribute value with fn(attr, url) -
+// useful for rewriting asset URLs to their fingerprinted/CDN equivalents
+// at render time without touching how the tree was built. Any other
+// attribute is left untouched.
+//
+// "srcset" holds a comma-separated list of "url descriptor" candidates
+// rather than a single URL, so each candidate's URL is rewritten on its
+// own and the descriptor (e.g. "2x" or "480w") is preserved as-is.
+//
+// RewriteURLs mutates the tree in place; it is a no-op, logging a
+// warning, on an element frozen with Freeze.
+func RewriteURLs(root *Element, fn func(attr, url string) string) {
+	Walk(root, func(e *Element) bool {
+		if e.rejectIfFrozen("RewriteURLs") {
+			return true
+		}
+
+		for _, a := range e.Attributes() {
+			if !urlAttrNames[a.Name] {
+				continue
+			}
+
+			if a.Name == "srcset" {
+				a.Value = rewriteSrcset(a.Value, fn)
+				continue
+			}
+
+			a.Value = fn(a.Name, a.Value)
+		}
+
+		return true
+	})
+}
+
+// rewriteSrcset rewrites the URL of each "url descriptor" candidate in a
+// srcset attribute value, leaving the descriptors themselves untouched.
+// Whitespace is normalized on the way back out: each candidate's URL and
+// descriptors are re-joined with single spaces, and candidates with ", ".
+func rewriteSrcset(val string, fn func(attr, url string) string) string {
+	candidates := strings.Split(val, ",")
+
+	for i, candidate := range candidates {
+		trimmed := strings.TrimSpace(candidate)
+		if trimmed == "" {
+			continue
+		}
+
+		fields := strings.Fields(trimmed)
+		fields[0] = fn("srcset", fields[0])
+		candidates[i] = strings.Join(fields, " ")
+	}
+
+	return strings.Join(candidates, ", ")
+}
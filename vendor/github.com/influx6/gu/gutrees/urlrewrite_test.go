@@ -0,0 +1,58 @@
+package gutrees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func fingerprint(attr, url string) string {
+	if strings.Contains(url, "?") {
+		return url + "&v=1"
+	}
+	return url + "?v=1"
+}
+
+func TestRewriteURLsRewritesSrc(t *testing.T) {
+	tree := elems.Image(attrs.Src("/logo.png"))
+
+	gutrees.RewriteURLs(tree, fingerprint)
+
+	a, err := gutrees.GetAttr(tree, "src")
+	if err != nil || a.Value != "/logo.png?v=1" {
+		t.Fatalf("\t%s\t Should rewrite src in place, got %q (err=%v)", failed, a, err)
+	}
+	t.Logf("\t%s\t Should rewrite a src attribute", success)
+}
+
+func TestRewriteURLsRewritesEachSrcsetCandidate(t *testing.T) {
+	tree := elems.Image(attrs.Srcset("/logo.png 1x, /logo@2x.png 2x"))
+
+	gutrees.RewriteURLs(tree, fingerprint)
+
+	a, err := gutrees.GetAttr(tree, "srcset")
+	if err != nil {
+		t.Fatalf("\t%s\t Should still have a srcset attribute, got err %v", failed, err)
+	}
+
+	want := "/logo.png?v=1 1x, /logo@2x.png?v=1 2x"
+	if a.Value != want {
+		t.Fatalf("\t%s\t Should rewrite each candidate's URL while keeping its descriptor, got %q want %q", failed, a.Value, want)
+	}
+	t.Logf("\t%s\t Should rewrite every URL in a multi-candidate srcset", success)
+}
+
+func TestRewriteURLsLeavesOtherAttributesAlone(t *testing.T) {
+	tree := elems.Anchor(attrs.ID("nav"), attrs.Href("/docs"))
+
+	gutrees.RewriteURLs(tree, fingerprint)
+
+	id, err := gutrees.GetAttr(tree, "id")
+	if err != nil || id.Value != "nav" {
+		t.Fatalf("\t%s\t Should leave a non-URL attribute untouched, got %q (err=%v)", failed, id, err)
+	}
+	t.Logf("\t%s\t Should leave non-URL attributes untouched", success)
+}
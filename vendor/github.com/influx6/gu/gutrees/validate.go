@@ -0,0 +1,99 @@
+package gutrees
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ValidationIssue describes a potential problem gutrees.Validate found in a
+// tree. It's dev-time feedback, not a hard failure - the tree is never
+// mutated or rejected because of it.
+type ValidationIssue struct {
+	Element     *Element
+	Description string
+}
+
+// Validator inspects a single element and returns any issues found in it.
+// Validate takes care of walking the tree, so a Validator only looks at the
+// element it's given.
+type Validator func(e *Element) []ValidationIssue
+
+// validators holds the Validators Validate runs over every element.
+var validators []Validator
+
+// RegisterValidator adds v to the set Validate runs over every element in a
+// tree.
+func RegisterValidator(v Validator) {
+	validators = append(validators, v)
+}
+
+// Validate walks root and its descendants, running every registered
+// Validator against each element, and returns every issue found.
+func Validate(root *Element) []ValidationIssue {
+	var issues []ValidationIssue
+
+	Walk(root, func(e *Element) bool {
+		for _, v := range validators {
+			issues = append(issues, v(e)...)
+		}
+		return true
+	})
+
+	return issues
+}
+
+func init() {
+	RegisterValidator(validateMediaQuery)
+}
+
+// knownMediaFeatures are the media-feature names a plausible media query is
+// expected to reference.
+var knownMediaFeatures = regexp.MustCompile(`\b(min-width|max-width|min-height|max-height|width|height|orientation|aspect-ratio|resolution|prefers-color-scheme|prefers-reduced-motion|hover|pointer)\b`)
+
+// validateMediaQuery flags media attributes on <source>/<link> that don't
+// look like plausible media queries: unbalanced parentheses, or no
+// recognizable media feature at all.
+func validateMediaQuery(e *Element) []ValidationIssue {
+	if e.Name() != "source" && e.Name() != "link" {
+		return nil
+	}
+
+	media, err := GetAttr(e, "media")
+	if err != nil || media.Value == "" {
+		return nil
+	}
+
+	if !balancedParens(media.Value) {
+		return []ValidationIssue{{
+			Element:     e,
+			Description: fmt.Sprintf("media query %q has unbalanced parentheses", media.Value),
+		}}
+	}
+
+	if !knownMediaFeatures.MatchString(media.Value) {
+		return []ValidationIssue{{
+			Element:     e,
+			Description: fmt.Sprintf("media query %q doesn't reference a known media feature", media.Value),
+		}}
+	}
+
+	return nil
+}
+
+// balancedParens reports whether s has balanced, non-negative parenthesis
+// nesting.
+func balancedParens(s string) bool {
+	depth := 0
+	for _, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth < 0 {
+			return false
+		}
+	}
+	return depth == 0
+}
@@ -0,0 +1,35 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestValidateFlagsMalformedMediaQuery(t *testing.T) {
+	tree := elems.Picture(
+		elems.Source(attrs.Media("(min-width: 800px"), attrs.Srcset("large.jpg")),
+	)
+
+	issues := gutrees.Validate(tree)
+	if len(issues) != 1 {
+		t.Fatalf("\t%s\t Should report one issue for the unbalanced media query, got %d", failed, len(issues))
+	}
+	if issues[0].Element.Name() != "source" {
+		t.Fatalf("\t%s\t Should report the offending <source> element, got %q", failed, issues[0].Element.Name())
+	}
+	t.Logf("\t%s\t Should flag a media query with unbalanced parentheses", success)
+}
+
+func TestValidateAcceptsWellFormedMediaQuery(t *testing.T) {
+	tree := elems.Picture(
+		elems.Source(attrs.Media("(min-width: 800px)"), attrs.Srcset("large.jpg")),
+	)
+
+	if issues := gutrees.Validate(tree); len(issues) != 0 {
+		t.Fatalf("\t%s\t Should not flag a well-formed media query, got %+v", failed, issues)
+	}
+	t.Logf("\t%s\t Should accept a well-formed media query", success)
+}
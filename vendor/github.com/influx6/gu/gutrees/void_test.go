@@ -0,0 +1,21 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestIsVoidTrueForVoidElement(t *testing.T) {
+	if !elems.Break().IsVoid() {
+		t.Fatalf("\t%s\t Should report true for a void element like <br>", failed)
+	}
+	t.Logf("\t%s\t Should report true for a void element", success)
+}
+
+func TestIsVoidFalseForNonVoidElement(t *testing.T) {
+	if elems.Div().IsVoid() {
+		t.Fatalf("\t%s\t Should report false for a non-void element like <div>", failed)
+	}
+	t.Logf("\t%s\t Should report false for a non-void element", success)
+}
@@ -0,0 +1,71 @@
+package gutrees
+
+// Walk performs a pre-order traversal of root and its descendants, calling
+// fn on each *Element. Returning false from fn skips that element's
+// subtree without stopping the rest of the walk.
+//
+// Walk does not collect children before visiting them, so removing a node's
+// own children from within fn while Walk is still visiting them is unsafe;
+// collect the elements to mutate while walking, then apply the mutation
+// after Walk returns.
+func Walk(root *Element, fn func(*Element) bool) {
+	if !fn(root) {
+		return
+	}
+
+	for _, ch := range root.Children() {
+		if ech, ok := ch.(*Element); ok {
+			Walk(ech, fn)
+		}
+	}
+}
+
+// WalkText performs a pre-order traversal of root and its descendants,
+// calling fn on every text node found.
+func WalkText(root *Element, fn func(text *Element)) {
+	Walk(root, func(e *Element) bool {
+		if e.Name() == "text" {
+			fn(e)
+		}
+		return true
+	})
+}
+
+// DefaultMaxDepth is the default ceiling WalkDepthLimited enforces, and
+// the default the streaming render path falls back to when an
+// ElementWriter's max depth hasn't been set with SetMaxDepth.
+const DefaultMaxDepth = 1000
+
+// WalkDepthLimited performs the same pre-order traversal as Walk, but
+// returns ErrMaxDepthExceeded instead of recursing past maxDepth levels
+// below root, guarding against a runaway or accidentally
+// self-referential tree hanging or crashing the walk. maxDepth <= 0 uses
+// DefaultMaxDepth.
+func WalkDepthLimited(root *Element, maxDepth int, fn func(*Element) bool) error {
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	return walkDepthLimited(root, maxDepth, 0, fn)
+}
+
+func walkDepthLimited(e *Element, maxDepth, depth int, fn func(*Element) bool) error {
+	if depth > maxDepth {
+		return ErrMaxDepthExceeded
+	}
+
+	if !fn(e) {
+		return nil
+	}
+
+	for _, ch := range e.Children() {
+		ech, ok := ch.(*Element)
+		if !ok {
+			continue
+		}
+		if err := walkDepthLimited(ech, maxDepth, depth+1, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
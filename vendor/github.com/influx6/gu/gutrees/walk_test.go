@@ -0,0 +1,69 @@
+package gutrees_test
+
+import (
+	"testing"
+
+	"github.com/influx6/gu/gutrees"
+	"github.com/influx6/gu/gutrees/attrs"
+	"github.com/influx6/gu/gutrees/elems"
+)
+
+func TestWalkVisitsEveryNode(t *testing.T) {
+	tree := elems.Div(
+		elems.Paragraph(elems.Text("a")),
+		elems.Paragraph(elems.Text("b")),
+	)
+
+	count := 0
+	gutrees.Walk(tree, func(e *gutrees.Element) bool {
+		count++
+		return true
+	})
+
+	// div + 2 paragraphs + 2 text nodes
+	if count != 5 {
+		t.Fatalf("\t%s\t Should visit every node in pre-order, got %d", failed, count)
+	}
+	t.Logf("\t%s\t Should visit every node in pre-order", success)
+}
+
+func TestWalkSkipsSubtreeWhenFnReturnsFalse(t *testing.T) {
+	tree := elems.Div(
+		elems.Paragraph(attrs.Class("skip"), elems.Text("hidden")),
+		elems.Paragraph(elems.Text("visible")),
+	)
+
+	var visitedText []string
+	gutrees.Walk(tree, func(e *gutrees.Element) bool {
+		if _, err := gutrees.GetAttr(e, "class"); err == nil {
+			return false
+		}
+		if e.Name() == "text" {
+			visitedText = append(visitedText, e.TextContent())
+		}
+		return true
+	})
+
+	if len(visitedText) != 1 || visitedText[0] != "visible" {
+		t.Fatalf("\t%s\t Should skip the subtree of a node whose fn returned false, got %v", failed, visitedText)
+	}
+	t.Logf("\t%s\t Should skip a subtree when fn returns false", success)
+}
+
+func TestWalkTextVisitsOnlyTextNodes(t *testing.T) {
+	tree := elems.Div(
+		attrs.Class("card"),
+		elems.Paragraph(elems.Text("a")),
+		elems.Paragraph(elems.Text("b")),
+	)
+
+	var texts []string
+	gutrees.WalkText(tree, func(text *gutrees.Element) {
+		texts = append(texts, text.TextContent())
+	})
+
+	if len(texts) != 2 || texts[0] != "a" || texts[1] != "b" {
+		t.Fatalf("\t%s\t Should visit only text nodes in order, got %v", failed, texts)
+	}
+	t.Logf("\t%s\t Should visit only text nodes", success)
+}